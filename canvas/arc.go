@@ -2,6 +2,7 @@ package canvas
 
 import (
 	"image/color"
+	"math"
 
 	"fyne.io/fyne/v2"
 )
@@ -27,6 +28,32 @@ type Arc struct {
 	StrokeColor  color.Color // The arc stroke color
 	StrokeWidth  float32     // The stroke width of the arc
 	CutoutRatio  float32     // Controls what portion of the inner should be cut out. A value of 0.0 results in a pie slice, while 1.0 results in a stroke.
+
+	// RingMode draws the arc as a pure ring segment stroked along the centreline
+	// between the outer and inner edges, with no radial closure lines. This renders
+	// cleanly at high CutoutRatio values where the closed annular outline can leave
+	// edge artifacts. CornerRadius is ignored in this mode.
+	//
+	// Since: 2.7
+	RingMode bool
+
+	// GlowColor, when set, renders a soft halo beneath the arc so active segments can
+	// pop against the track, for example while hovered. Nil disables the glow and
+	// preserves the arc's regular appearance.
+	//
+	// Since: 2.7
+	GlowColor color.Color
+	// GlowWidth is how far the glow reaches beyond the arc edges. Zero disables the glow.
+	//
+	// Since: 2.7
+	GlowWidth float32
+
+	// Segments controls how many curve segments are used to tessellate a full circle when
+	// the arc is rasterized. A value of 0 chooses automatically based on the radius, using
+	// more segments for larger radii so edges stay smooth on low-DPI displays.
+	//
+	// Since: 2.7
+	Segments int
 }
 
 // Hide will set this arc to not be visible.
@@ -64,6 +91,60 @@ func (a *Arc) Resize(s fyne.Size) {
 	repaint(a)
 }
 
+// Path samples the arc centerline into the given number of straight segments,
+// returning segments+1 positions from StartAngle to EndAngle in the coordinate
+// space of the arc's Position (its center). The centerline sits halfway between
+// the outer edge and the CutoutRatio inner edge. This allows geometry to be
+// verified in tests without rasterizing the arc.
+//
+// Since: 2.7
+func (a *Arc) Path(segments int) []fyne.Position {
+	if segments < 1 {
+		return nil
+	}
+
+	size := a.Size()
+	outer := float64(fyne.Min(size.Width, size.Height)) / 2
+	mid := outer * float64(1+a.CutoutRatio) / 2
+	start := float64(a.StartAngle)
+	sweep := float64(ArcSweep(a.StartAngle, a.EndAngle))
+	center := a.Position()
+
+	points := make([]fyne.Position, 0, segments+1)
+	for i := 0; i <= segments; i++ {
+		angle := (start + sweep*float64(i)/float64(segments)) * math.Pi / 180
+		points = append(points, fyne.NewPos(
+			center.X+float32(math.Sin(angle)*mid),
+			center.Y-float32(math.Cos(angle)*mid)))
+	}
+	return points
+}
+
+// NormalizeAngle wraps the given angle in degrees into the range [0, 360).
+//
+// Since: 2.7
+func NormalizeAngle(angle float32) float32 {
+	angle = float32(math.Mod(float64(angle), 360))
+	if angle < 0 {
+		angle += 360
+	}
+	return angle
+}
+
+// ArcSweep returns the signed angular extent in degrees swept when drawing from start
+// to end, positive clockwise and negative counter-clockwise, clamped to one full circle.
+//
+// Since: 2.7
+func ArcSweep(start, end float32) float32 {
+	sweep := end - start
+	if sweep > 360 {
+		return 360
+	} else if sweep < -360 {
+		return -360
+	}
+	return sweep
+}
+
 // NewArc returns a new Arc instance with the specified start and end angles (in degrees), fill color and cutout ratio.
 func NewArc(startAngle, endAngle, cutoutRatio float32, color color.Color) *Arc {
 	return &Arc{
@@ -2,6 +2,7 @@ package canvas_test
 
 import (
 	"image/color"
+	"math"
 	"testing"
 
 	"fyne.io/fyne/v2"
@@ -143,3 +144,46 @@ func TestArc_RadiusMaximum(t *testing.T) {
 	arc.CutoutRatio = 0
 	test.AssertRendersToImage(t, "maximum_rounded_arc_0_350_no_inner_radius.png", c)
 }
+
+func TestNormalizeAngle(t *testing.T) {
+	assert.Equal(t, float32(0), canvas.NormalizeAngle(0))
+	assert.Equal(t, float32(0), canvas.NormalizeAngle(360))
+	assert.Equal(t, float32(90), canvas.NormalizeAngle(450))
+	assert.Equal(t, float32(270), canvas.NormalizeAngle(-90))
+	assert.Equal(t, float32(225), canvas.NormalizeAngle(-135))
+	assert.Equal(t, float32(180), canvas.NormalizeAngle(-900))
+}
+
+func TestArcSweep(t *testing.T) {
+	assert.Equal(t, float32(270), canvas.ArcSweep(-135, 135))
+	assert.Equal(t, float32(-270), canvas.ArcSweep(135, -135))
+	assert.Equal(t, float32(0), canvas.ArcSweep(90, 90))
+
+	// sweeps are clamped to one full circle
+	assert.Equal(t, float32(360), canvas.ArcSweep(0, 720))
+	assert.Equal(t, float32(-360), canvas.ArcSweep(720, 0))
+}
+
+func TestArc_Path(t *testing.T) {
+	arc := canvas.NewDoughnutArc(0, 90, color.White)
+	arc.Resize(fyne.NewSize(100, 100))
+
+	points := arc.Path(2)
+	assert.Len(t, points, 3)
+
+	// centerline halfway between the outer edge and the 0.5 cutout
+	assert.InDelta(t, 0, points[0].X, 1e-4)
+	assert.InDelta(t, -37.5, points[0].Y, 1e-4)
+	assert.InDelta(t, 37.5*math.Sqrt2/2, points[1].X, 1e-4)
+	assert.InDelta(t, -37.5*math.Sqrt2/2, points[1].Y, 1e-4)
+	assert.InDelta(t, 37.5, points[2].X, 1e-4)
+	assert.InDelta(t, 0, points[2].Y, 1e-4)
+
+	// points follow the arc's center position
+	arc.Move(fyne.NewPos(50, 50))
+	points = arc.Path(2)
+	assert.InDelta(t, 50, points[0].X, 1e-4)
+	assert.InDelta(t, 12.5, points[0].Y, 1e-4)
+
+	assert.Nil(t, arc.Path(0))
+}
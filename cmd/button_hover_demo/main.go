@@ -10,91 +10,48 @@ import (
 	"fyne.io/fyne/v2/widget"
 )
 
-// InteractiveButton is a custom widget that implements all desktop interaction interfaces:
+// newDemoButton configures a widget.InteractiveButton that reports all desktop
+// interaction interfaces to the console:
 // - desktop.Hoverable: Mouse enter/move/exit events
 // - desktop.Mouseable: Mouse button down/up events
 // - desktop.Cursorable: Custom cursor appearance
 // - desktop.Keyable: Keyboard key down/up events (requires focus)
-type InteractiveButton struct {
-	widget.Button
-}
-
-// NewInteractiveButton creates a new interactive button
-func NewInteractiveButton(text string, tapped func()) *InteractiveButton {
-	btn := &InteractiveButton{}
-	btn.Text = text
-	btn.OnTapped = tapped
-	btn.ExtendBaseWidget(btn)
-	return btn
-}
-
-// ========== desktop.Hoverable interface ==========
-
-// MouseIn is called when the mouse pointer enters the button
-func (b *InteractiveButton) MouseIn(e *desktop.MouseEvent) {
-	fmt.Printf("Hoverable.MouseIn: Mouse entered at (%.2f, %.2f)\n",
-		e.Position.X, e.Position.Y)
-}
-
-// MouseMoved is called when the mouse pointer moves over the button
-func (b *InteractiveButton) MouseMoved(e *desktop.MouseEvent) {
-	fmt.Printf("Hoverable.MouseMoved: Mouse moved to (%.2f, %.2f)\n",
-		e.Position.X, e.Position.Y)
-}
-
-// MouseOut is called when the mouse pointer leaves the button
-func (b *InteractiveButton) MouseOut() {
-	fmt.Println("Hoverable.MouseOut: Mouse left the button")
-}
-
-// ========== desktop.Mouseable interface ==========
-
-// MouseDown is called when a mouse button is pressed on the button
-func (b *InteractiveButton) MouseDown(e *desktop.MouseEvent) {
-	buttonName := getButtonName(e.Button)
-	modifiers := getModifierString(e.Modifier)
-	fmt.Printf("Mouseable.MouseDown: %s button pressed at (%.2f, %.2f)%s\n",
-		buttonName, e.Position.X, e.Position.Y, modifiers)
-}
-
-// MouseUp is called when a mouse button is released on the button
-func (b *InteractiveButton) MouseUp(e *desktop.MouseEvent) {
-	buttonName := getButtonName(e.Button)
-	modifiers := getModifierString(e.Modifier)
-	fmt.Printf("Mouseable.MouseUp: %s button released at (%.2f, %.2f)%s\n",
-		buttonName, e.Position.X, e.Position.Y, modifiers)
-}
-
-// ========== desktop.Cursorable interface ==========
+func newDemoButton(text string, tapped func()) *widget.InteractiveButton {
+	btn := widget.NewInteractiveButton(text, tapped)
 
-// Cursor returns the cursor type to display when hovering over the button
-func (b *InteractiveButton) Cursor() desktop.Cursor {
 	// Return a pointer cursor (hand) to indicate the button is clickable
-	return desktop.PointerCursor
-}
-
-// ========== desktop.Keyable interface (requires fyne.Focusable) ==========
-
-// KeyDown is called when a key is pressed while the button has focus
-func (b *InteractiveButton) KeyDown(e *fyne.KeyEvent) {
-	modifiers := getModifierString(e.Modifier)
-	fmt.Printf("Keyable.KeyDown: Key '%s' pressed%s\n", e.Name, modifiers)
-}
-
-// KeyUp is called when a key is released while the button has focus
-func (b *InteractiveButton) KeyUp(e *fyne.KeyEvent) {
-	modifiers := getModifierString(e.Modifier)
-	fmt.Printf("Keyable.KeyUp: Key '%s' released%s\n", e.Name, modifiers)
-}
-
-// FocusGained is called when the button gains keyboard focus
-func (b *InteractiveButton) FocusGained() {
-	fmt.Println("Focusable.FocusGained: Button now has keyboard focus (try pressing keys!)")
-}
+	btn.HoverCursor = desktop.PointerCursor
 
-// FocusLost is called when the button loses keyboard focus
-func (b *InteractiveButton) FocusLost() {
-	fmt.Println("Focusable.FocusLost: Button lost keyboard focus")
+	btn.OnMouseIn = func(e *desktop.MouseEvent) {
+		fmt.Printf("Hoverable.MouseIn: Mouse entered at (%.2f, %.2f)\n",
+			e.Position.X, e.Position.Y)
+	}
+	btn.OnMouseMoved = func(e *desktop.MouseEvent) {
+		fmt.Printf("Hoverable.MouseMoved: Mouse moved to (%.2f, %.2f)\n",
+			e.Position.X, e.Position.Y)
+	}
+	btn.OnMouseOut = func() {
+		fmt.Println("Hoverable.MouseOut: Mouse left the button")
+	}
+	btn.OnMouseDown = func(e *desktop.MouseEvent) {
+		buttonName := getButtonName(e.Button)
+		modifiers := getModifierString(e.Modifier)
+		fmt.Printf("Mouseable.MouseDown: %s button pressed at (%.2f, %.2f)%s\n",
+			buttonName, e.Position.X, e.Position.Y, modifiers)
+	}
+	btn.OnMouseUp = func(e *desktop.MouseEvent) {
+		buttonName := getButtonName(e.Button)
+		modifiers := getModifierString(e.Modifier)
+		fmt.Printf("Mouseable.MouseUp: %s button released at (%.2f, %.2f)%s\n",
+			buttonName, e.Position.X, e.Position.Y, modifiers)
+	}
+	btn.OnKeyDown = func(e *fyne.KeyEvent) {
+		fmt.Printf("Keyable.KeyDown: Key '%s' pressed\n", e.Name)
+	}
+	btn.OnKeyUp = func(e *fyne.KeyEvent) {
+		fmt.Printf("Keyable.KeyUp: Key '%s' released\n", e.Name)
+	}
+	return btn
 }
 
 // ========== Helper functions ==========
@@ -141,7 +98,7 @@ func main() {
 	myWindow.Resize(fyne.NewSize(600, 500))
 
 	// Create an interactive button that implements all desktop interfaces
-	interactiveButton := NewInteractiveButton("Interactive Button - Try Everything!", func() {
+	interactiveButton := newDemoButton("Interactive Button - Try Everything!", func() {
 		fmt.Println("✓ Button.Tapped: Button was clicked!")
 	})
 
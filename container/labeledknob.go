@@ -0,0 +1,46 @@
+package container // import "fyne.io/fyne/v2/container"
+
+import (
+	"strconv"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/widget"
+)
+
+// NewLabeledKnob arranges an icon above a rotating knob with a live value label
+// underneath - the common layout for a dashboard cell. The label is bound to
+// valueBinding, which this helper updates from the knob on every change, using
+// the knob's ValueFormatter when one is set. Any OnChanged callback already on
+// the knob continues to fire after the binding update. A nil icon omits the
+// icon row.
+//
+// Since: 2.7
+func NewLabeledKnob(icon fyne.Resource, knob *widget.RotatingKnob, valueBinding binding.String) *fyne.Container {
+	format := func(value float64) string {
+		if knob.ValueFormatter != nil {
+			return knob.ValueFormatter(value)
+		}
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	}
+
+	previous := knob.OnChanged
+	knob.OnChanged = func(value float64) {
+		valueBinding.Set(format(value))
+		if previous != nil {
+			previous(value)
+		}
+	}
+	valueBinding.Set(format(knob.Value()))
+
+	label := widget.NewLabelWithData(valueBinding)
+	label.Alignment = fyne.TextAlignCenter
+
+	objects := make([]fyne.CanvasObject, 0, 3)
+	if icon != nil {
+		objects = append(objects, NewCenter(widget.NewIcon(icon)))
+	}
+	objects = append(objects, knob, label)
+
+	return NewVBox(objects...)
+}
@@ -0,0 +1,58 @@
+package container
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+func TestNewLabeledKnob(t *testing.T) {
+	knob := widget.NewRotatingKnob(0, 100)
+	knob.SetValue(25)
+	value := binding.NewString()
+
+	cell := NewLabeledKnob(theme.VolumeUpIcon(), knob, value)
+	assert.Equal(t, 3, len(cell.Objects))
+
+	text, _ := value.Get()
+	assert.Equal(t, "25", text)
+
+	knob.SetValue(40)
+	text, _ = value.Get()
+	assert.Equal(t, "40", text)
+}
+
+func TestNewLabeledKnob_NilIcon(t *testing.T) {
+	knob := widget.NewRotatingKnob(0, 100)
+	cell := NewLabeledKnob(nil, knob, binding.NewString())
+	assert.Equal(t, 2, len(cell.Objects))
+}
+
+func TestNewLabeledKnob_Formatter(t *testing.T) {
+	knob := widget.NewRotatingKnob(0, 100)
+	knob.ValueFormatter = func(v float64) string {
+		return "50%"
+	}
+	knob.SetValue(50)
+	value := binding.NewString()
+
+	NewLabeledKnob(nil, knob, value)
+	text, _ := value.Get()
+	assert.Equal(t, "50%", text)
+}
+
+func TestNewLabeledKnob_ChainsOnChanged(t *testing.T) {
+	knob := widget.NewRotatingKnob(0, 100)
+	changed := 0.0
+	knob.OnChanged = func(v float64) {
+		changed = v
+	}
+
+	NewLabeledKnob(nil, knob, binding.NewString())
+	knob.SetValue(70)
+	assert.Equal(t, 70.0, changed)
+}
@@ -0,0 +1,75 @@
+// Package game provides reusable tic-tac-toe game logic, keeping board state and
+// win detection separate from any UI so example games can share one implementation.
+package game
+
+// BoardSize is the width and height of a tic-tac-toe board.
+const BoardSize = 3
+
+// Board holds the state of a tic-tac-toe game. Cells contain "X", "O" or are empty.
+// The zero value is an empty board ready to play.
+type Board struct {
+	cells [BoardSize][BoardSize]string
+}
+
+// NewBoard returns an empty board ready to play.
+func NewBoard() *Board {
+	return &Board{}
+}
+
+// At returns the mark at the given cell, or an empty string for an unplayed cell.
+func (b *Board) At(row, col int) string {
+	if row < 0 || row >= BoardSize || col < 0 || col >= BoardSize {
+		return ""
+	}
+	return b.cells[row][col]
+}
+
+// Place puts the given mark at the cell, reporting false if the cell is out of
+// bounds, already taken, or the game has been won.
+func (b *Board) Place(row, col int, mark string) bool {
+	if row < 0 || row >= BoardSize || col < 0 || col >= BoardSize {
+		return false
+	}
+	if b.cells[row][col] != "" || b.Winner() != "" {
+		return false
+	}
+	b.cells[row][col] = mark
+	return true
+}
+
+// Winner returns the mark that has completed a row, column or diagonal,
+// or an empty string when nobody has won yet.
+func (b *Board) Winner() string {
+	for i := 0; i < BoardSize; i++ {
+		if m := b.cells[i][0]; m != "" && m == b.cells[i][1] && m == b.cells[i][2] {
+			return m
+		}
+		if m := b.cells[0][i]; m != "" && m == b.cells[1][i] && m == b.cells[2][i] {
+			return m
+		}
+	}
+	if m := b.cells[0][0]; m != "" && m == b.cells[1][1] && m == b.cells[2][2] {
+		return m
+	}
+	if m := b.cells[0][2]; m != "" && m == b.cells[1][1] && m == b.cells[2][0] {
+		return m
+	}
+	return ""
+}
+
+// IsFull reports whether every cell has been played, a draw if there is no winner.
+func (b *Board) IsFull() bool {
+	for _, row := range b.cells {
+		for _, cell := range row {
+			if cell == "" {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Reset clears the board for a new game.
+func (b *Board) Reset() {
+	b.cells = [BoardSize][BoardSize]string{}
+}
@@ -0,0 +1,76 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBoard_Place(t *testing.T) {
+	board := NewBoard()
+
+	assert.True(t, board.Place(0, 0, "X"))
+	assert.Equal(t, "X", board.At(0, 0))
+
+	// a taken cell or out-of-bounds position cannot be played
+	assert.False(t, board.Place(0, 0, "O"))
+	assert.False(t, board.Place(-1, 0, "O"))
+	assert.False(t, board.Place(0, 3, "O"))
+}
+
+func TestBoard_Winner(t *testing.T) {
+	board := NewBoard()
+	assert.Equal(t, "", board.Winner())
+
+	// row win
+	board.Place(1, 0, "X")
+	board.Place(1, 1, "X")
+	board.Place(1, 2, "X")
+	assert.Equal(t, "X", board.Winner())
+
+	// no more moves are accepted after a win
+	assert.False(t, board.Place(0, 0, "O"))
+
+	// column win
+	board.Reset()
+	board.Place(0, 2, "O")
+	board.Place(1, 2, "O")
+	board.Place(2, 2, "O")
+	assert.Equal(t, "O", board.Winner())
+
+	// diagonal win
+	board.Reset()
+	board.Place(0, 0, "X")
+	board.Place(1, 1, "X")
+	board.Place(2, 2, "X")
+	assert.Equal(t, "X", board.Winner())
+
+	// anti-diagonal win
+	board.Reset()
+	board.Place(0, 2, "O")
+	board.Place(1, 1, "O")
+	board.Place(2, 0, "O")
+	assert.Equal(t, "O", board.Winner())
+}
+
+func TestBoard_IsFull(t *testing.T) {
+	board := NewBoard()
+	assert.False(t, board.IsFull())
+
+	marks := [BoardSize][BoardSize]string{
+		{"X", "O", "X"},
+		{"X", "O", "O"},
+		{"O", "X", "X"},
+	}
+	for row := range marks {
+		for col := range marks[row] {
+			board.Place(row, col, marks[row][col])
+		}
+	}
+	assert.True(t, board.IsFull())
+	assert.Equal(t, "", board.Winner())
+
+	board.Reset()
+	assert.False(t, board.IsFull())
+	assert.Equal(t, "", board.At(1, 1))
+}
@@ -0,0 +1,84 @@
+package cache
+
+import (
+	"image"
+	"image/color"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/internal/async"
+)
+
+var arcs async.Map[fyne.CanvasObject, *arcInfo]
+
+// ArcRenderKey captures every input that shapes an arc rasterization, so a cached
+// image is reused only while all of them are unchanged. Colors are stored as their
+// resolved channel values rather than color.Color interfaces, as the key is compared
+// with == and an uncomparable Color implementation would panic.
+type ArcRenderKey struct {
+	Width, Height            int
+	StartAngle, EndAngle     float32
+	StrokeWidth, CutoutRatio float32
+	CornerRadius, GlowWidth  float32
+	Segments                 int
+	RingMode                 bool
+	FillColor                ArcColorKey
+	StrokeColor              ArcColorKey
+	GlowColor                ArcColorKey
+}
+
+// ArcColorKey is the comparable form of a color used in an ArcRenderKey, holding
+// the alpha-premultiplied channels from color.Color.RGBA. A nil color leaves Set
+// false, distinguishing "no color" from transparent black.
+type ArcColorKey struct {
+	R, G, B, A uint32
+	Set        bool
+}
+
+// ArcColorKeyFor resolves the given color into its comparable key form.
+func ArcColorKeyFor(c color.Color) ArcColorKey {
+	if c == nil {
+		return ArcColorKey{}
+	}
+	r, g, b, a := c.RGBA()
+	return ArcColorKey{R: r, G: g, B: b, A: a, Set: true}
+}
+
+// GetArc gets the rasterized arc image from cache if one exists for exactly the
+// given render key, or nil when the arc must be re-tessellated.
+func GetArc(arc fyne.CanvasObject, key ArcRenderKey) *image.RGBA {
+	ainfo, ok := arcs.Load(arc)
+	if !ok || ainfo == nil || ainfo.key != key {
+		return nil
+	}
+
+	ainfo.setAlive()
+	return ainfo.pix
+}
+
+// SetArc sets a rasterized arc image into the cache map along with the render key
+// that shaped it, replacing any previous entry for the arc.
+func SetArc(arc fyne.CanvasObject, key ArcRenderKey, pix *image.RGBA) {
+	ainfo := &arcInfo{
+		key: key,
+		pix: pix,
+	}
+	ainfo.setAlive()
+	arcs.Store(arc, ainfo)
+}
+
+type arcInfo struct {
+	expiringCache
+	key ArcRenderKey
+	pix *image.RGBA
+}
+
+// destroyExpiredArcs destroys expired arcs cache data.
+func destroyExpiredArcs(now time.Time) {
+	arcs.Range(func(key fyne.CanvasObject, ainfo *arcInfo) bool {
+		if ainfo.isExpired(now) {
+			arcs.Delete(key)
+		}
+		return true
+	})
+}
@@ -42,6 +42,7 @@ func Clean(canvasRefreshed bool) {
 	if !canvasRefreshed && now.Sub(lastClean) < cleanTaskInterval {
 		return
 	}
+	destroyExpiredArcs(now)
 	destroyExpiredSvgs(now)
 	destroyExpiredFontMetrics(now)
 	if canvasRefreshed {
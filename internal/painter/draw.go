@@ -7,6 +7,7 @@ import (
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/internal/cache"
 
 	"github.com/srwiley/rasterx"
 	"golang.org/x/image/math/fixed"
@@ -25,6 +26,20 @@ func DrawArc(arc *canvas.Arc, vectorPad float32, scale func(float32) float32) *i
 	width := int(scale(size.Width + vectorPad*2))
 	height := int(scale(size.Height + vectorPad*2))
 
+	key := cache.ArcRenderKey{
+		Width: width, Height: height,
+		StartAngle: arc.StartAngle, EndAngle: arc.EndAngle,
+		StrokeWidth: arc.StrokeWidth, CutoutRatio: arc.CutoutRatio,
+		CornerRadius: arc.CornerRadius, GlowWidth: arc.GlowWidth,
+		Segments: arc.Segments, RingMode: arc.RingMode,
+		FillColor:   cache.ArcColorKeyFor(arc.FillColor),
+		StrokeColor: cache.ArcColorKeyFor(arc.StrokeColor),
+		GlowColor:   cache.ArcColorKeyFor(arc.GlowColor),
+	}
+	if raw := cache.GetArc(arc, key); raw != nil {
+		return raw // unchanged geometry, skip the re-tessellation
+	}
+
 	raw := image.NewRGBA(image.Rect(0, 0, width, height))
 	scanner := rasterx.NewScannerGV(int(size.Width), int(size.Height), raw, raw.Bounds())
 
@@ -42,6 +57,7 @@ func DrawArc(arc *canvas.Arc, vectorPad float32, scale func(float32) float32) *i
 	sweep := endRad - startRad
 	if sweep == 0 {
 		// nothing to draw
+		cache.SetArc(arc, key, raw)
 		return raw
 	}
 
@@ -55,11 +71,34 @@ func DrawArc(arc *canvas.Arc, vectorPad float32, scale func(float32) float32) *i
 	outerRadius = scale(outerRadius)
 	innerRadius = scale(innerRadius)
 
-	if arc.FillColor != nil {
+	glow := float64(scale(arc.GlowWidth))
+	if arc.GlowColor != nil && glow > 0 {
+		// approximate a blur with layered strokes of growing width and fading alpha
+		const glowPasses = 3
+		r, g, b, a := arc.GlowColor.RGBA()
+		for pass := glowPasses; pass >= 1; pass-- {
+			fade := func(c uint32) uint16 { return uint16(c / (glowPasses + 1)) }
+			dasher := rasterx.NewDasher(width, height, scanner)
+			dasher.SetColor(color.RGBA64{R: fade(r), G: fade(g), B: fade(b), A: fade(a)})
+			dasher.SetStroke(fixed.Int26_6(glow*2*float64(pass)/glowPasses*64), 0, nil, nil, nil, 0, nil, 0)
+			drawRoundArc(dasher, centerX, centerY, float64(outerRadius), float64(innerRadius), startRad, sweep, float64(cornerRadius), arc.Segments)
+			dasher.Draw()
+		}
+	}
+
+	if arc.RingMode {
+		if arc.FillColor != nil && outerRadius > innerRadius {
+			ring := rasterx.NewDasher(width, height, scanner)
+			ring.SetColor(arc.FillColor)
+			ring.SetStroke(fixed.Int26_6(float64(outerRadius-innerRadius)*64), 0, nil, nil, nil, 0, nil, 0)
+			drawRingArc(ring, centerX, centerY, float64(outerRadius+innerRadius)/2, startRad, sweep, arc.Segments)
+			ring.Draw()
+		}
+	} else if arc.FillColor != nil {
 		filler := rasterx.NewFiller(width, height, scanner)
 		filler.SetColor(arc.FillColor)
 		// rasterx.AddArc is not used because it does not support rounded corners
-		drawRoundArc(filler, centerX, centerY, float64(outerRadius), float64(innerRadius), startRad, sweep, float64(cornerRadius))
+		drawRoundArc(filler, centerX, centerY, float64(outerRadius), float64(innerRadius), startRad, sweep, float64(cornerRadius), arc.Segments)
 		filler.Draw()
 	}
 
@@ -69,10 +108,11 @@ func DrawArc(arc *canvas.Arc, vectorPad float32, scale func(float32) float32) *i
 		dasher.SetColor(arc.StrokeColor)
 		dasher.SetStroke(fixed.Int26_6(stroke*64), 0, nil, nil, nil, 0, nil, 0)
 		// rasterx.AddArc is not used because it does not support rounded corners
-		drawRoundArc(dasher, centerX, centerY, float64(outerRadius), float64(innerRadius), startRad, sweep, float64(cornerRadius))
+		drawRoundArc(dasher, centerX, centerY, float64(outerRadius), float64(innerRadius), startRad, sweep, float64(cornerRadius), arc.Segments)
 		dasher.Draw()
 	}
 
+	cache.SetArc(arc, key, raw)
 	return raw
 }
 
@@ -403,47 +443,85 @@ func drawRegularPolygon(cx, cy, radius, cornerRadius, rot float64, sides int, p
 	p.Stop(true)
 }
 
+// autoArcSegments picks the tessellation count for a full circle of the given pixel
+// radius, using more bezier segments for larger radii so edges stay smooth.
+func autoArcSegments(radius float64) int {
+	segments := int(math.Ceil(radius / 32))
+	if segments < 4 {
+		segments = 4
+	} else if segments > 32 {
+		segments = 32
+	}
+	return segments
+}
+
+// cosSinPoint returns the point on a circle of the given radius at the given angle,
+// in the Unit circle coordinate system.
+func cosSinPoint(cx, cy, r, ang float64) (x, y float64) {
+	return cx + r*math.Cos(ang), cy - r*math.Sin(ang)
+}
+
+// addCircularArcSegments appends a circular arc to the current path using cubic Bezier
+// approximation. 'adder' must already be positioned at the arc start point.
+// sweep is signed (positive = CCW, negative = CW).
+func addCircularArcSegments(adder rasterx.Adder, cx, cy, r, start, sweep float64, segments int) {
+	if sweep == 0 || r == 0 {
+		return
+	}
+	perCircle := segments
+	if perCircle <= 0 {
+		perCircle = autoArcSegments(r)
+	}
+	segCount := int(math.Ceil(math.Abs(sweep) / (2 * math.Pi) * float64(perCircle)))
+	if segCount < 1 {
+		segCount = 1
+	}
+	da := sweep / float64(segCount)
+
+	for i := 0; i < segCount; i++ {
+		a1 := start + float64(i)*da
+		a2 := a1 + da
+
+		x1, y1 := cosSinPoint(cx, cy, r, a1)
+		x2, y2 := cosSinPoint(cx, cy, r, a2)
+
+		k := 4.0 / 3.0 * math.Tan((a2-a1)/4.0)
+		// tangent unit vectors on our param (x = cx+rcos, y = cy-rsin)
+		c1x := x1 + k*r*(-math.Sin(a1))
+		c1y := y1 + k*r*(-math.Cos(a1))
+		c2x := x2 - k*r*(-math.Sin(a2))
+		c2y := y2 - k*r*(-math.Cos(a2))
+
+		adder.CubeBezier(
+			rasterx.ToFixedP(c1x, c1y),
+			rasterx.ToFixedP(c2x, c2y),
+			rasterx.ToFixedP(x2, y2),
+		)
+	}
+}
+
+// drawRingArc strokes an open arc along the centreline between the outer and inner
+// radii, producing a pure ring segment with no radial closure lines.
+func drawRingArc(adder rasterx.Adder, cx, cy, mid, start, sweep float64, segments int) {
+	if sweep == 0 || mid <= 0 {
+		return
+	}
+
+	sx, sy := cosSinPoint(cx, cy, mid, start)
+	adder.Start(rasterx.ToFixedP(sx, sy))
+	addCircularArcSegments(adder, cx, cy, mid, start, sweep, segments)
+	adder.Stop(false)
+}
+
 // drawRoundArc constructs a rounded pie slice or annular sector
 // it uses the Unit circle coordinate system
-func drawRoundArc(adder rasterx.Adder, cx, cy, outer, inner, start, sweep, cr float64) {
+func drawRoundArc(adder rasterx.Adder, cx, cy, outer, inner, start, sweep, cr float64, segments int) {
 	if sweep == 0 {
 		return
 	}
 
-	cosSinPoint := func(cx, cy, r, ang float64) (x, y float64) {
-		return cx + r*math.Cos(ang), cy - r*math.Sin(ang)
-	}
-
-	// addCircularArc appends a circular arc to the current path using cubic Bezier approximation.
-	// 'adder' must already be positioned at the arc start point.
-	// sweep is signed (positive = CCW, negative = CW).
 	addCircularArc := func(adder rasterx.Adder, cx, cy, r, start, sweep float64) {
-		if sweep == 0 || r == 0 {
-			return
-		}
-		segCount := int(math.Ceil(math.Abs(sweep) / (math.Pi / 2.0)))
-		da := sweep / float64(segCount)
-
-		for i := 0; i < segCount; i++ {
-			a1 := start + float64(i)*da
-			a2 := a1 + da
-
-			x1, y1 := cosSinPoint(cx, cy, r, a1)
-			x2, y2 := cosSinPoint(cx, cy, r, a2)
-
-			k := 4.0 / 3.0 * math.Tan((a2-a1)/4.0)
-			// tangent unit vectors on our param (x = cx+rcos, y = cy-rsin)
-			c1x := x1 + k*r*(-math.Sin(a1))
-			c1y := y1 + k*r*(-math.Cos(a1))
-			c2x := x2 - k*r*(-math.Sin(a2))
-			c2y := y2 - k*r*(-math.Cos(a2))
-
-			adder.CubeBezier(
-				rasterx.ToFixedP(c1x, c1y),
-				rasterx.ToFixedP(c2x, c2y),
-				rasterx.ToFixedP(x2, y2),
-			)
-		}
+		addCircularArcSegments(adder, cx, cy, r, start, sweep, segments)
 	}
 
 	// full-circle/donut paths (two closed subpaths: outer CCW, inner CW if inner > 0)
@@ -1,6 +1,8 @@
 package painter_test
 
 import (
+	"bytes"
+	"image/color"
 	"testing"
 
 	"fyne.io/fyne/v2"
@@ -118,3 +120,117 @@ func TestPaint_NormalizeArcAngles(t *testing.T) {
 		}
 	}
 }
+
+func TestPaint_DrawArcSegments(t *testing.T) {
+	newArc := func(segments int) *canvas.Arc {
+		arc := canvas.NewDoughnutArc(0, 270, color.White)
+		arc.Segments = segments
+		arc.Resize(fyne.NewSize(60, 60))
+		return arc
+	}
+	noScale := func(v float32) float32 { return v }
+
+	auto := painter.DrawArc(newArc(0), 0, noScale)
+	matched := painter.DrawArc(newArc(4), 0, noScale)
+	coarse := painter.DrawArc(newArc(1), 0, noScale)
+
+	// a small radius tessellates to four segments per circle automatically
+	if !bytes.Equal(auto.Pix, matched.Pix) {
+		t.Error("auto segment count should match four segments for a small arc")
+	}
+	if bytes.Equal(auto.Pix, coarse.Pix) {
+		t.Error("reducing the segment count should change the rasterized arc")
+	}
+}
+
+func TestPaint_DrawArcGlow(t *testing.T) {
+	newArc := func(glow color.Color) *canvas.Arc {
+		arc := canvas.NewDoughnutArc(0, 270, color.White)
+		arc.GlowColor = glow
+		arc.GlowWidth = 4
+		arc.Resize(fyne.NewSize(60, 60))
+		return arc
+	}
+	noScale := func(v float32) float32 { return v }
+
+	plain := painter.DrawArc(newArc(nil), 0, noScale)
+	glowing := painter.DrawArc(newArc(color.White), 0, noScale)
+
+	// a nil glow color preserves the regular output
+	noWidth := newArc(color.White)
+	noWidth.GlowWidth = 0
+	if !bytes.Equal(plain.Pix, painter.DrawArc(noWidth, 0, noScale).Pix) {
+		t.Error("zero glow width should not change the rasterized arc")
+	}
+	if bytes.Equal(plain.Pix, glowing.Pix) {
+		t.Error("a glow should change the rasterized arc")
+	}
+}
+
+func TestPaint_DrawArcRingMode(t *testing.T) {
+	newArc := func(ring bool, cutout float32) *canvas.Arc {
+		arc := canvas.NewArc(0, 270, cutout, color.White)
+		arc.RingMode = ring
+		arc.Resize(fyne.NewSize(60, 60))
+		return arc
+	}
+	noScale := func(v float32) float32 { return v }
+
+	closed := painter.DrawArc(newArc(false, 0.9), 0, noScale)
+	ring := painter.DrawArc(newArc(true, 0.9), 0, noScale)
+	if bytes.Equal(closed.Pix, ring.Pix) {
+		t.Error("ring mode should change how a high-cutout arc is rasterized")
+	}
+
+	// a full cutout leaves the ring nothing to stroke
+	empty := painter.DrawArc(newArc(true, 1), 0, noScale)
+	for _, p := range empty.Pix {
+		if p != 0 {
+			t.Error("a ring of zero thickness should draw nothing")
+			break
+		}
+	}
+}
+
+func TestPaint_DrawArcCached(t *testing.T) {
+	arc := canvas.NewDoughnutArc(0, 270, color.White)
+	arc.Resize(fyne.NewSize(60, 60))
+	noScale := func(v float32) float32 { return v }
+
+	first := painter.DrawArc(arc, 0, noScale)
+	if painter.DrawArc(arc, 0, noScale) != first {
+		t.Error("an unchanged arc should reuse its cached rasterization")
+	}
+
+	arc.EndAngle = 180
+	second := painter.DrawArc(arc, 0, noScale)
+	if second == first {
+		t.Error("changing an angle should invalidate the cached rasterization")
+	}
+
+	arc.Resize(fyne.NewSize(80, 80))
+	if painter.DrawArc(arc, 0, noScale) == second {
+		t.Error("resizing should invalidate the cached rasterization")
+	}
+}
+
+// paletteColor carries a slice, making it an uncomparable color.Color implementation.
+type paletteColor struct {
+	stops []color.Color
+}
+
+func (p paletteColor) RGBA() (r, g, b, a uint32) {
+	return p.stops[0].RGBA()
+}
+
+func TestPaint_DrawArcUncomparableColor(t *testing.T) {
+	arc := canvas.NewDoughnutArc(0, 270, paletteColor{stops: []color.Color{color.White}})
+	arc.Resize(fyne.NewSize(60, 60))
+	noScale := func(v float32) float32 { return v }
+
+	// comparing cache keys must not panic however the color is implemented
+	first := painter.DrawArc(arc, 0, noScale)
+	if painter.DrawArc(arc, 0, noScale) != first {
+		t.Error("an unchanged arc should reuse its cached rasterization")
+	}
+}
@@ -282,12 +282,17 @@ func (p *painter) drawPolygon(polygon *canvas.Polygon, pos fyne.Position, frame
 }
 
 func (p *painter) drawArc(arc *canvas.Arc, pos fyne.Position, frame fyne.Size) {
-	if ((arc.FillColor == color.Transparent || arc.FillColor == nil) && (arc.StrokeColor == color.Transparent || arc.StrokeColor == nil || arc.StrokeWidth == 0)) || arc.StartAngle == arc.EndAngle {
+	glowWidth := float32(0)
+	if arc.GlowColor != nil && arc.GlowColor != color.Transparent {
+		glowWidth = arc.GlowWidth
+	}
+	if ((arc.FillColor == color.Transparent || arc.FillColor == nil) && (arc.StrokeColor == color.Transparent || arc.StrokeColor == nil || arc.StrokeWidth == 0) && glowWidth == 0) || arc.StartAngle == arc.EndAngle {
 		return
 	}
 
 	// Vertex: BEG
-	bounds, points := p.vecRectCoords(pos, arc, frame, 0.0)
+	// a negative pad grows the quad so the glow halo is not clipped at the object bounds
+	bounds, points := p.vecRectCoordsWithPad(pos, arc, frame, -glowWidth, -glowWidth)
 	program := p.arcProgram
 	p.ctx.UseProgram(program.ref)
 	p.updateBuffer(program.buff, points)
@@ -337,6 +342,16 @@ func (p *painter) drawArc(arc *canvas.Arc, pos fyne.Position, frame fyne.Size) {
 	r, g, b, a = getFragmentColor(strokeColor)
 	p.SetUniform4f(program, "stroke_color", r, g, b, a)
 
+	glowWidthScaled := roundToPixel(glowWidth*p.pixScale, 1.0)
+	p.SetUniform1f(program, "glow_width", glowWidthScaled)
+
+	glowColor := arc.GlowColor
+	if glowColor == nil {
+		glowColor = color.Transparent
+	}
+	r, g, b, a = getFragmentColor(glowColor)
+	p.SetUniform4f(program, "glow_color", r, g, b, a)
+
 	p.logError()
 	// Fragment: END
 
@@ -138,7 +138,7 @@ func (p *painter) Init() {
 			"start_angle", "end_angle",
 			"edge_softness", "corner_radius",
 			"stroke_width", "stroke_color",
-			"fill_color",
+			"fill_color", "glow_width", "glow_color",
 		)
 		p.enableAttribArrays(p.arcProgram, "vert", "normal")
 		compiled = []ProgramState{
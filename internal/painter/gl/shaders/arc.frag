@@ -15,6 +15,8 @@ uniform vec4 fill_color;
 uniform float corner_radius;
 uniform float stroke_width;
 uniform vec4 stroke_color;
+uniform float glow_width;
+uniform vec4 glow_color;
 
 const float PI = 3.141592653589793;
 
@@ -110,7 +112,23 @@ void main()
 
     // smooth edges
     float final_alpha = smoothstep(edge_softness, -edge_softness, dist);
-    
+
+    if (glow_width > 0.0 && glow_color.a > 0.0)
+    {
+        // soft halo fading out over glow_width from the shape edge, composited beneath it
+        // the 0.75 factor matches the layered-stroke approximation of the software rasterizer
+        float glow_alpha = glow_color.a * 0.75 * (1.0 - smoothstep(0.0, glow_width, dist));
+        float shape_alpha = final_color.a * final_alpha;
+        float out_alpha = shape_alpha + glow_alpha * (1.0 - shape_alpha);
+        vec3 out_rgb = final_color.rgb;
+        if (out_alpha > 0.0)
+        {
+            out_rgb = (final_color.rgb * shape_alpha + glow_color.rgb * glow_alpha * (1.0 - shape_alpha)) / out_alpha;
+        }
+        gl_FragColor = vec4(out_rgb, out_alpha);
+        return;
+    }
+
     // apply the final alpha to the combined color
     gl_FragColor = vec4(final_color.rgb, final_color.a * final_alpha);
 }
@@ -0,0 +1,45 @@
+package test
+
+import (
+	"fyne.io/fyne/v2"
+	intdriver "fyne.io/fyne/v2/internal/driver"
+)
+
+// AccessibilityNode is a compact textual description of one interactive widget,
+// suitable for asserting the accessible state of a whole screen at once.
+//
+// Since: 2.7
+type AccessibilityNode struct {
+	Role  string
+	Name  string
+	Value string
+}
+
+// AccessibilityDescriber is implemented by widgets that can describe their accessible
+// role, name and current value, such as widget.RotatingKnob.
+//
+// Since: 2.7
+type AccessibilityDescriber interface {
+	AccessibilityNode() (role, name, value string)
+}
+
+// DumpAccessibilityNodes walks the visible object tree below root and returns a node
+// for every widget that describes itself through AccessibilityDescriber, or failing
+// that accepts focus, in draw order. Focusable widgets without a description report
+// the role "focusable".
+//
+// Since: 2.7
+func DumpAccessibilityNodes(root fyne.CanvasObject) []AccessibilityNode {
+	var nodes []AccessibilityNode
+	intdriver.WalkVisibleObjectTree(root, func(o fyne.CanvasObject, _, _ fyne.Position, _ fyne.Size) bool {
+		switch w := o.(type) {
+		case AccessibilityDescriber:
+			role, name, value := w.AccessibilityNode()
+			nodes = append(nodes, AccessibilityNode{Role: role, Name: name, Value: value})
+		case fyne.Focusable:
+			nodes = append(nodes, AccessibilityNode{Role: "focusable"})
+		}
+		return false
+	}, nil)
+	return nodes
+}
@@ -401,8 +401,18 @@ func (r *markupRenderer) writeText(t *fynecanvas.Text, attrs map[string]*string)
 	r.writeCloseTag("text")
 }
 
+// markupNamer is implemented by widgets that want a stable identifying name in the
+// rendered markup, independent of their Go type. This keeps golden files unambiguous
+// when widgets share an implementation.
+type markupNamer interface {
+	MarkupName() string
+}
+
 func (r *markupRenderer) writeWidget(w fyne.Widget, attrs map[string]*string) {
 	r.setStringAttr(attrs, "type", reflect.TypeOf(w).String())
+	if n, ok := w.(markupNamer); ok {
+		r.setStringAttr(attrs, "name", n.MarkupName())
+	}
 	r.writeTag("widget", false, attrs)
 	r.w.WriteRune('\n')
 	r.indentation++
@@ -678,3 +678,24 @@ func (r *markupRendererTestWidgetRenderer) Refresh() {
 }
 
 var _ fyne.WidgetRenderer = (*markupRendererTestWidgetRenderer)(nil)
+
+type namedTestWidget struct {
+	widget.BaseWidget
+}
+
+func (w *namedTestWidget) MarkupName() string {
+	return "NamedTestWidget"
+}
+
+func (w *namedTestWidget) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(fynecanvas.NewRectangle(color.Black))
+}
+
+func Test_snapshot_markupName(t *testing.T) {
+	named := &namedTestWidget{}
+	named.ExtendBaseWidget(named)
+	c := NewCanvas()
+	c.SetContent(named)
+
+	assert.Contains(t, snapshot(c), `name="NamedTestWidget"`)
+}
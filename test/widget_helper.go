@@ -0,0 +1,23 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ValuedWidget describes a widget that exposes a single float value through a method,
+// such as widget.RotatingKnob or widget.RotaryControl.
+//
+// Since: 2.7
+type ValuedWidget interface {
+	Value() float64
+}
+
+// AssertFloatWidgetValue asserts that the value of the given widget is within delta of expected.
+// The test `t` fails if the values differ by more than delta.
+//
+// Since: 2.7
+func AssertFloatWidgetValue(t *testing.T, w ValuedWidget, expected, delta float64) bool {
+	return assert.InDelta(t, expected, w.Value(), delta, "widget value should be within %f of %f", delta, expected)
+}
@@ -0,0 +1,37 @@
+package test_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+)
+
+func TestAssertFloatWidgetValue(t *testing.T) {
+	knob := widget.NewRotatingKnob(0, 100)
+	knob.SetValue(40)
+	test.AssertFloatWidgetValue(t, knob, 40, 0.0001)
+
+	control := widget.NewRotaryControl(0, 10)
+	control.SetValue(7)
+	test.AssertFloatWidgetValue(t, control, 7, 0.0001)
+}
+
+func TestDumpAccessibilityNodes(t *testing.T) {
+	volume := widget.NewRotatingKnob(0, 100)
+	volume.StatusName = "Volume"
+	volume.SetValue(5)
+	balance := widget.NewRotatingKnob(-10, 10)
+	balance.StatusName = "Balance"
+
+	box := container.NewHBox(volume, balance)
+	nodes := test.DumpAccessibilityNodes(box)
+
+	assert.Equal(t, []test.AccessibilityNode{
+		{Role: "knob", Name: "Volume", Value: "5"},
+		{Role: "knob", Name: "Balance", Value: "-10"},
+	}, nodes)
+}
@@ -0,0 +1,34 @@
+package theme
+
+import "image/color"
+
+// AccessibleColors groups a small set of colours chosen together so that text and
+// controls drawn with them meet the WCAG 2.1 AA contrast thresholds, for custom
+// widgets that need guaranteed legibility rather than theme-dependent colours.
+//
+// Since: 2.7
+type AccessibleColors struct {
+	// Foreground is the text and icon colour, contrasting 17.2:1 against Background
+	// - well beyond the 4.5:1 AA requirement for normal text.
+	Foreground color.Color
+	// Background is the surface colour the other entries are checked against.
+	Background color.Color
+	// Focus highlights the focused element, contrasting 7.3:1 against Background so
+	// it clears the 3:1 requirement for non-text indicators with room to spare.
+	Focus color.Color
+	// Hover marks the element under the pointer, contrasting 13.4:1 against
+	// Background, again comfortably above the 3:1 non-text minimum.
+	Hover color.Color
+}
+
+// AccessiblePalette is a ready-made set of WCAG 2.1 AA compliant colours - near
+// white text on a near black surface with bright focus and hover accents. Custom
+// widgets can draw from it instead of copying contrast-checked literals around.
+//
+// Since: 2.7
+var AccessiblePalette = AccessibleColors{
+	Foreground: color.NRGBA{R: 0xf5, G: 0xf5, B: 0xf5, A: 0xff},
+	Background: color.NRGBA{R: 0x12, G: 0x12, B: 0x12, A: 0xff},
+	Focus:      color.NRGBA{R: 0x4d, G: 0xa6, B: 0xff, A: 0xff},
+	Hover:      color.NRGBA{R: 0xff, G: 0xd7, B: 0x00, A: 0xff},
+}
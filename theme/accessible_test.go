@@ -0,0 +1,44 @@
+package theme_test
+
+import (
+	"math"
+	"testing"
+
+	"image/color"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2/theme"
+)
+
+func TestAccessiblePalette_Contrast(t *testing.T) {
+	p := theme.AccessiblePalette
+
+	assert.GreaterOrEqual(t, contrastRatio(p.Foreground, p.Background), 4.5,
+		"foreground text must meet WCAG AA for normal text")
+	assert.GreaterOrEqual(t, contrastRatio(p.Focus, p.Background), 3.0,
+		"focus indicator must meet WCAG AA for non-text contrast")
+	assert.GreaterOrEqual(t, contrastRatio(p.Hover, p.Background), 3.0,
+		"hover indicator must meet WCAG AA for non-text contrast")
+}
+
+// contrastRatio computes the WCAG 2.1 contrast ratio between two colours.
+func contrastRatio(a, b color.Color) float64 {
+	la, lb := relativeLuminance(a), relativeLuminance(b)
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + 0.05) / (lb + 0.05)
+}
+
+func relativeLuminance(c color.Color) float64 {
+	r, g, b, _ := c.RGBA()
+	lin := func(v uint32) float64 {
+		s := float64(v) / 0xffff
+		if s <= 0.03928 {
+			return s / 12.92
+		}
+		return math.Pow((s+0.055)/1.055, 2.4)
+	}
+	return 0.2126*lin(r) + 0.7152*lin(g) + 0.0722*lin(b)
+}
@@ -0,0 +1,63 @@
+package widget
+
+import (
+	"math"
+
+	"fyne.io/fyne/v2"
+)
+
+// AngleFromPoint returns the angle of pos as seen from center, in degrees, where
+// zero points straight up and positive angles run clockwise - the convention
+// canvas.Arc and RotatingKnob share. The result lies in (-180, 180]. Custom
+// radial widgets can use this instead of re-deriving the trigonometry.
+//
+// Since: 2.7
+func AngleFromPoint(center, pos fyne.Position) float64 {
+	dx := float64(pos.X - center.X)
+	dy := float64(pos.Y - center.Y)
+	return math.Atan2(dx, -dy) * 180 / math.Pi
+}
+
+// ValueFromAngle maps an angle in degrees onto the value range between min and
+// max, across the sweep running from start to end. Angles outside the sweep
+// normally clamp to the nearest end, with the dead zone split evenly either side
+// of the point opposite the sweep's middle; with wrapping they wrap around the
+// sweep instead, as an endless encoder would. A zero-length sweep is treated as
+// a full rotation. This is the pure core of RotatingKnob's pointer mapping,
+// exported so other radial widgets can reuse the tested math.
+//
+// Since: 2.7
+func ValueFromAngle(angle, start, end, min, max float64, wrapping bool) float64 {
+	if start == end {
+		end = start + 360
+	}
+
+	if wrapping {
+		span := end - start
+		offset := math.Mod(angle-start, span)
+		if offset*span < 0 { // Mod keeps the dividend's sign, we want the sweep's
+			offset += span
+		}
+		angle = start + offset
+	} else {
+		// centre the angle on the middle of the sweep so the clamp splits the dead zone evenly
+		mid := (start + end) / 2
+		offset := math.Mod(angle-mid+180, 360)
+		if offset < 0 {
+			offset += 360
+		}
+		angle = mid - 180 + offset
+
+		lo, hi := start, end
+		if lo > hi {
+			lo, hi = hi, lo
+		}
+		if angle < lo {
+			angle = lo
+		} else if angle > hi {
+			angle = hi
+		}
+	}
+
+	return min + (angle-start)/(end-start)*(max-min)
+}
@@ -0,0 +1,56 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2"
+)
+
+func TestAngleFromPoint(t *testing.T) {
+	center := fyne.NewPos(50, 50)
+
+	for name, tt := range map[string]struct {
+		pos   fyne.Position
+		angle float64
+	}{
+		"up":        {fyne.NewPos(50, 10), 0},
+		"right":     {fyne.NewPos(90, 50), 90},
+		"down":      {fyne.NewPos(50, 90), 180},
+		"left":      {fyne.NewPos(10, 50), -90},
+		"upRight":   {fyne.NewPos(90, 10), 45},
+		"downLeft":  {fyne.NewPos(10, 90), -135},
+		"offCentre": {fyne.NewPos(150, 150), 135},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.InDelta(t, tt.angle, AngleFromPoint(center, tt.pos), 0.0001)
+		})
+	}
+}
+
+func TestValueFromAngle(t *testing.T) {
+	for name, tt := range map[string]struct {
+		angle, start, end, min, max float64
+		wrapping                    bool
+		value                       float64
+	}{
+		"sweepStart":    {-135, -135, 135, 0, 100, false, 0},
+		"sweepMiddle":   {0, -135, 135, 0, 100, false, 50},
+		"sweepEnd":      {135, -135, 135, 0, 100, false, 100},
+		"clampsLow":     {-160, -135, 135, 0, 100, false, 0},
+		"clampsHigh":    {160, -135, 135, 0, 100, false, 100},
+		"deadZoneSplit": {179, -135, 135, 0, 100, false, 100},
+		"reversedSweep": {45, 135, -135, 0, 100, false, 33.3333},
+		"offsetRange":   {0, -90, 90, -10, 10, false, 0},
+		"fullCircle":    {90, 0, 0, 0, 360, false, 90},
+		"wrapsForward":  {45, 0, 0, 0, 360, true, 45},
+		"wrapsAround":   {-90, 0, 0, 0, 360, true, 270},
+		"wrapsPartial":  {180, -135, 135, 0, 100, true, 16.6667},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got := ValueFromAngle(tt.angle, tt.start, tt.end, tt.min, tt.max, tt.wrapping)
+			assert.InDelta(t, tt.value, got, 0.001)
+		})
+	}
+}
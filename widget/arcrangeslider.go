@@ -0,0 +1,354 @@
+package widget
+
+import (
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/internal/widget"
+	"fyne.io/fyne/v2/theme"
+)
+
+var (
+	_ fyne.Draggable   = (*ArcRangeSlider)(nil)
+	_ fyne.Disableable = (*ArcRangeSlider)(nil)
+)
+
+// thumb identifiers for the drag in progress
+const (
+	arcRangeThumbNone = iota
+	arcRangeThumbLow
+	arcRangeThumbHigh
+)
+
+// ArcRangeSlider is the radial analog of a range slider: two draggable thumbs on
+// a single arc select a low and high value between fixed bounds. Dragging a thumb
+// past the other either swaps the thumbs or clamps against them depending on
+// AllowCross.
+//
+// Since: 2.7
+type ArcRangeSlider struct {
+	BaseWidget
+
+	Min  float64
+	Max  float64
+	Step float64
+
+	// StartAngle and EndAngle define the sweep of the arc in degrees, using the same
+	// convention as canvas.Arc (0 is top, positive is clockwise). StartAngle maps to Min
+	// and EndAngle maps to Max.
+	StartAngle float32
+	EndAngle   float32
+
+	// AllowCross lets a thumb be dragged past the other, swapping which thumb is the
+	// low end. When false the moving thumb clamps against the stationary one.
+	//
+	// Since: 2.7
+	AllowCross bool
+
+	// OnChanged fires whenever either end of the range moves.
+	OnChanged func(low, high float64) `json:"-"`
+
+	low, high float64
+	disabled  bool
+	dragThumb int // which thumb the current drag is moving
+}
+
+// NewArcRangeSlider returns a range slider selecting values between the given
+// minimum and maximum. The range starts fully open.
+//
+// Since: 2.7
+func NewArcRangeSlider(min, max float64) *ArcRangeSlider {
+	slider := &ArcRangeSlider{
+		Min:        min,
+		Max:        max,
+		Step:       1,
+		StartAngle: -135,
+		EndAngle:   135,
+		low:        min,
+		high:       max,
+	}
+	slider.ExtendBaseWidget(slider)
+	return slider
+}
+
+// Low returns the current low end of the selected range.
+//
+// Since: 2.7
+func (s *ArcRangeSlider) Low() float64 {
+	return s.low
+}
+
+// High returns the current high end of the selected range.
+//
+// Since: 2.7
+func (s *ArcRangeSlider) High() float64 {
+	return s.high
+}
+
+// SetLow moves the low end of the range, clamping into the bounds. Values past
+// the high end swap the thumbs when AllowCross is set, otherwise they clamp.
+//
+// Since: 2.7
+func (s *ArcRangeSlider) SetLow(value float64) {
+	value = s.clamp(value)
+	if value > s.high {
+		if s.AllowCross {
+			s.setRange(s.high, value)
+			return
+		}
+		value = s.high
+	}
+	s.setRange(value, s.high)
+}
+
+// SetHigh moves the high end of the range, clamping into the bounds. Values past
+// the low end swap the thumbs when AllowCross is set, otherwise they clamp.
+//
+// Since: 2.7
+func (s *ArcRangeSlider) SetHigh(value float64) {
+	value = s.clamp(value)
+	if value < s.low {
+		if s.AllowCross {
+			s.setRange(value, s.low)
+			return
+		}
+		value = s.low
+	}
+	s.setRange(s.low, value)
+}
+
+// Dragged is called when a drag event occurs, moving whichever thumb was nearer
+// the pointer when the drag began.
+func (s *ArcRangeSlider) Dragged(e *fyne.DragEvent) {
+	if s.disabled {
+		return
+	}
+
+	value := s.valueForPoint(&e.PointEvent)
+	if s.dragThumb == arcRangeThumbNone {
+		if math.Abs(value-s.low) <= math.Abs(value-s.high) {
+			s.dragThumb = arcRangeThumbLow
+		} else {
+			s.dragThumb = arcRangeThumbHigh
+		}
+	}
+
+	if s.dragThumb == arcRangeThumbLow {
+		if value > s.high && s.AllowCross {
+			s.dragThumb = arcRangeThumbHigh // crossed over, carry on as the high thumb
+		}
+		s.SetLow(value)
+		return
+	}
+	if value < s.low && s.AllowCross {
+		s.dragThumb = arcRangeThumbLow
+	}
+	s.SetHigh(value)
+}
+
+// DragEnd is called when the drag ends, releasing the thumb it was moving.
+func (s *ArcRangeSlider) DragEnd() {
+	s.dragThumb = arcRangeThumbNone
+}
+
+// Disable turns off interaction with this slider.
+func (s *ArcRangeSlider) Disable() {
+	if s.disabled {
+		return
+	}
+	s.disabled = true
+	s.Refresh()
+}
+
+// Enable restores interaction with this slider.
+func (s *ArcRangeSlider) Enable() {
+	if !s.disabled {
+		return
+	}
+	s.disabled = false
+	s.Refresh()
+}
+
+// Disabled reports whether this slider is currently disabled.
+func (s *ArcRangeSlider) Disabled() bool {
+	return s.disabled
+}
+
+// MinSize returns the size that this widget should not shrink below.
+func (s *ArcRangeSlider) MinSize() fyne.Size {
+	s.ExtendBaseWidget(s)
+	return s.BaseWidget.MinSize()
+}
+
+// setRange stores both ends, snapping to Step and firing OnChanged when either moved.
+func (s *ArcRangeSlider) setRange(low, high float64) {
+	low, high = s.snap(low), s.snap(high)
+	if low == s.low && high == s.high {
+		return
+	}
+
+	s.low, s.high = low, high
+	s.Refresh()
+	if s.OnChanged != nil {
+		s.OnChanged(low, high)
+	}
+}
+
+func (s *ArcRangeSlider) clamp(value float64) float64 {
+	return math.Min(math.Max(value, s.Min), s.Max)
+}
+
+func (s *ArcRangeSlider) snap(value float64) float64 {
+	if s.Step == 0 {
+		return value
+	}
+	return s.clamp(s.Min + math.Round((value-s.Min)/s.Step)*s.Step)
+}
+
+// angleRange returns the configured sweep, falling back to a full rotation when
+// StartAngle and EndAngle coincide.
+func (s *ArcRangeSlider) angleRange() (start, end float32) {
+	start, end = s.StartAngle, s.EndAngle
+	if start == end {
+		end = start + 360
+	}
+	return start, end
+}
+
+// angleForValue maps a value onto the arc sweep, in canvas.Arc degrees.
+func (s *ArcRangeSlider) angleForValue(value float64) float32 {
+	ratio := float32(0)
+	if s.Max != s.Min {
+		ratio = float32((value - s.Min) / (s.Max - s.Min))
+	}
+	start, end := s.angleRange()
+	return start + ratio*(end-start)
+}
+
+// radius returns the arc radius at the current size.
+func (s *ArcRangeSlider) radius() float32 {
+	size := s.Size()
+	pad := s.Theme().Size(theme.SizeNameInnerPadding)
+	r := (fyne.Min(size.Width, size.Height) - pad*2) / 2
+	if r < 0 {
+		r = 0
+	}
+	return r
+}
+
+// valueForPoint maps a pointer position within the widget onto the value range,
+// clamping positions outside the sweep to the nearest end.
+func (s *ArcRangeSlider) valueForPoint(e *fyne.PointEvent) float64 {
+	size := s.Size()
+	dx := float64(e.Position.X - size.Width/2)
+	dy := float64(e.Position.Y - size.Height/2)
+
+	angle := float32(math.Atan2(dx, -dy) * 180 / math.Pi) // 0 is top, positive is clockwise
+	rangeStart, rangeEnd := s.angleRange()
+
+	// centre the angle on the middle of the sweep so the clamp splits the dead zone evenly
+	mid := (rangeStart + rangeEnd) / 2
+	angle = mid - 180 + canvas.NormalizeAngle(angle-mid+180)
+
+	start, end := rangeStart, rangeEnd
+	if start > end {
+		start, end = end, start
+	}
+	if angle < start {
+		angle = start
+	} else if angle > end {
+		angle = end
+	}
+
+	ratio := float64((angle - rangeStart) / (rangeEnd - rangeStart))
+	return s.Min + ratio*(s.Max-s.Min)
+}
+
+// CreateRenderer links this widget to its renderer.
+func (s *ArcRangeSlider) CreateRenderer() fyne.WidgetRenderer {
+	s.ExtendBaseWidget(s)
+	th := s.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+
+	track := canvas.NewDoughnutArc(s.StartAngle, s.EndAngle, th.Color(theme.ColorNameInputBackground, v))
+	active := canvas.NewDoughnutArc(s.StartAngle, s.StartAngle, th.Color(theme.ColorNameForeground, v))
+	track.RingMode, active.RingMode = true, true
+	lowThumb := &canvas.Circle{FillColor: th.Color(theme.ColorNameForeground, v)}
+	highThumb := &canvas.Circle{FillColor: th.Color(theme.ColorNameForeground, v)}
+
+	objects := []fyne.CanvasObject{track, active, lowThumb, highThumb}
+	r := &arcRangeSliderRenderer{widget.NewBaseRenderer(objects), track, active, lowThumb, highThumb, s}
+	r.Refresh() // prepare for first draw
+	return r
+}
+
+type arcRangeSliderRenderer struct {
+	widget.BaseRenderer
+	track     *canvas.Arc
+	active    *canvas.Arc
+	lowThumb  *canvas.Circle
+	highThumb *canvas.Circle
+	slider    *ArcRangeSlider
+}
+
+// MinSize calculates the minimum size of the slider.
+func (r *arcRangeSliderRenderer) MinSize() fyne.Size {
+	th := r.slider.Theme()
+	return fyne.NewSquareSize(th.Size(theme.SizeNameInlineIcon)*2 + th.Size(theme.SizeNameInnerPadding)*2)
+}
+
+// Layout the components of the slider.
+func (r *arcRangeSliderRenderer) Layout(size fyne.Size) {
+	th := r.slider.Theme()
+
+	radius := r.slider.radius()
+	center := fyne.NewPos(size.Width/2, size.Height/2)
+
+	// canvas.Arc is centred on its position
+	r.track.Move(center)
+	r.track.Resize(fyne.NewSquareSize(radius * 2))
+	r.active.Move(center)
+	r.active.Resize(fyne.NewSquareSize(radius * 2))
+
+	thumbDiameter := th.Size(theme.SizeNameInlineIcon) - 4 // match slider thumbs
+	placeThumb := func(thumb *canvas.Circle, value float64) {
+		angle := float64(r.slider.angleForValue(value)) * math.Pi / 180
+		thumbCenter := fyne.NewPos(
+			center.X+float32(math.Sin(angle))*radius*0.75,
+			center.Y-float32(math.Cos(angle))*radius*0.75)
+		thumb.Move(fyne.NewPos(thumbCenter.X-thumbDiameter/2, thumbCenter.Y-thumbDiameter/2))
+		thumb.Resize(fyne.NewSquareSize(thumbDiameter))
+	}
+	placeThumb(r.lowThumb, r.slider.low)
+	placeThumb(r.highThumb, r.slider.high)
+}
+
+// Refresh updates the slider state for drawing.
+func (r *arcRangeSliderRenderer) Refresh() {
+	th := r.slider.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+
+	foreground := th.Color(theme.ColorNameForeground, v)
+	trackColor := th.Color(theme.ColorNameInputBackground, v)
+	if r.slider.disabled {
+		foreground = th.Color(theme.ColorNameDisabled, v)
+		trackColor = th.Color(theme.ColorNameDisabledButton, v)
+	}
+
+	start, end := r.slider.angleRange()
+	r.track.FillColor = trackColor
+	r.track.StartAngle = start
+	r.track.EndAngle = end
+
+	// the active arc spans the selected range between the thumbs
+	r.active.FillColor = foreground
+	r.active.StartAngle = r.slider.angleForValue(r.slider.low)
+	r.active.EndAngle = r.slider.angleForValue(r.slider.high)
+
+	r.lowThumb.FillColor = foreground
+	r.highThumb.FillColor = foreground
+
+	r.Layout(r.slider.Size())
+	canvas.Refresh(r.slider.super())
+}
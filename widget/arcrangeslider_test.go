@@ -0,0 +1,95 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArcRangeSlider_SetLowHigh(t *testing.T) {
+	slider := NewArcRangeSlider(0, 100)
+	assert.Equal(t, 0.0, slider.Low())
+	assert.Equal(t, 100.0, slider.High())
+
+	ranges := [][2]float64{}
+	slider.OnChanged = func(low, high float64) {
+		ranges = append(ranges, [2]float64{low, high})
+	}
+
+	slider.SetLow(20)
+	slider.SetHigh(80)
+	assert.Equal(t, [][2]float64{{20, 100}, {20, 80}}, ranges)
+
+	// without AllowCross the ends clamp against each other
+	slider.SetLow(90)
+	assert.Equal(t, 80.0, slider.Low())
+	slider.SetHigh(-10)
+	assert.Equal(t, 80.0, slider.High())
+
+	// with AllowCross the thumbs swap instead
+	slider.AllowCross = true
+	slider.SetLow(95)
+	assert.Equal(t, 80.0, slider.Low())
+	assert.Equal(t, 95.0, slider.High())
+}
+
+func TestArcRangeSlider_Dragged(t *testing.T) {
+	slider := NewArcRangeSlider(0, 100)
+	slider.Resize(fyne.NewSize(100, 100))
+	slider.SetLow(10)
+	slider.SetHigh(90)
+
+	// a drag grabs whichever thumb is nearer the pointer, here the high one
+	slider.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(90, 50)}})
+	assert.Equal(t, 10.0, slider.Low())
+	assert.Equal(t, 83.0, slider.High())
+	slider.DragEnd()
+
+	// grab the low thumb and move it to the top of the arc
+	slider.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(12, 62)}})
+	slider.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(50, 10)}})
+	assert.Equal(t, 50.0, slider.Low())
+	assert.Equal(t, 83.0, slider.High())
+	slider.DragEnd()
+
+	// without AllowCross the held thumb clamps against the other
+	slider.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(50, 10)}})
+	slider.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(90, 50)}})
+	assert.Equal(t, 83.0, slider.Low())
+	assert.Equal(t, 83.0, slider.High())
+	slider.DragEnd()
+
+	slider.Disable()
+	slider.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(50, 10)}})
+	assert.Equal(t, 83.0, slider.Low())
+}
+
+func TestArcRangeSlider_DraggedCross(t *testing.T) {
+	slider := NewArcRangeSlider(0, 100)
+	slider.AllowCross = true
+	slider.Resize(fyne.NewSize(100, 100))
+	slider.SetLow(40)
+	slider.SetHigh(50)
+
+	// grab the low thumb, then drag it past the high one to swap their roles mid-gesture
+	slider.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(31, 13)}})
+	assert.Equal(t, 40.0, slider.Low())
+	slider.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(90, 50)}})
+	assert.Equal(t, 50.0, slider.Low())
+	assert.Equal(t, 83.0, slider.High())
+	slider.DragEnd()
+}
+
+func TestArcRangeSlider_Renderer(t *testing.T) {
+	slider := NewArcRangeSlider(0, 100)
+	slider.Resize(fyne.NewSize(100, 100))
+	slider.SetLow(25)
+	slider.SetHigh(75)
+
+	r := test.TempWidgetRenderer(t, slider).(*arcRangeSliderRenderer)
+	assert.Equal(t, slider.angleForValue(25), r.active.StartAngle)
+	assert.Equal(t, slider.angleForValue(75), r.active.EndAngle)
+}
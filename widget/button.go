@@ -61,6 +61,12 @@ type Button struct {
 
 	OnTapped func() `json:"-"`
 
+	// HoverCursor is the cursor shown while the pointer hovers the button, for example
+	// desktop.PointerCursor for a web style hand. When nil the default cursor is used.
+	//
+	// Since: 2.7
+	HoverCursor desktop.Cursor
+
 	hovered, focused bool
 	tapAnim          *fyne.Animation
 }
@@ -122,8 +128,11 @@ func (b *Button) CreateRenderer() fyne.WidgetRenderer {
 	return r
 }
 
-// Cursor returns the cursor type of this widget
+// Cursor returns the cursor type of this widget, HoverCursor if one is configured
 func (b *Button) Cursor() desktop.Cursor {
+	if b.HoverCursor != nil {
+		return b.HoverCursor
+	}
 	return desktop.DefaultCursor
 }
 
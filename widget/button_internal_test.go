@@ -13,6 +13,7 @@ import (
 	"fyne.io/fyne/v2/test"
 	"fyne.io/fyne/v2/theme"
 
+	"fyne.io/fyne/v2/driver/desktop"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -233,3 +234,11 @@ func TestButtonRenderer_TapAnimation(t *testing.T) {
 	button.tapAnim.Tick(0.5)
 	test.AssertImageMatches(t, "button/tap_animation.png", w.Canvas().Capture())
 }
+
+func TestButton_HoverCursor(t *testing.T) {
+	button := NewButton("Tap", nil)
+	assert.Equal(t, desktop.DefaultCursor, button.Cursor())
+
+	button.HoverCursor = desktop.PointerCursor
+	assert.Equal(t, desktop.PointerCursor, button.Cursor())
+}
@@ -0,0 +1,55 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComparisonKnob_EditReference(t *testing.T) {
+	knob := NewComparisonKnob(0, 100)
+	knob.SetActiveValue(60)
+	knob.SetReferenceValue(20)
+	assert.Equal(t, 60.0, knob.ActiveValue())
+	assert.Equal(t, 20.0, knob.ReferenceValue())
+
+	changes := []float64{}
+	knob.OnChanged = func(value float64) {
+		changes = append(changes, value)
+	}
+
+	// gestures target the active value by default
+	knob.Resize(fyne.NewSize(100, 100))
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(90, 50)}})
+	assert.Equal(t, 83.0, knob.ActiveValue())
+	assert.Equal(t, 20.0, knob.ReferenceValue())
+
+	knob.EditReference(true)
+	knob.DragEnd()
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(50, 10)}})
+	assert.Equal(t, 83.0, knob.ActiveValue())
+	assert.Equal(t, 50.0, knob.ReferenceValue())
+
+	assert.Equal(t, []float64{83, 50}, changes)
+}
+
+func TestComparisonKnob_DimmedReference(t *testing.T) {
+	knob := NewComparisonKnob(0, 100)
+	knob.SetActiveValue(60)
+	knob.SetReferenceValue(20)
+	knob.Resize(fyne.NewSize(100, 100))
+
+	r := test.TempWidgetRenderer(t, knob).(*comparisonKnobRenderer)
+	assert.NotEqual(t, r.active.FillColor, r.reference.FillColor)
+	assert.Equal(t, r.reference.FillColor, r.referenceThumb.FillColor)
+	assert.Equal(t, knob.angleForValue(60), r.active.EndAngle)
+	assert.Equal(t, knob.angleForValue(20), r.reference.EndAngle)
+
+	// swapping the editing target keeps the on-screen roles
+	knob.EditReference(true)
+	assert.Equal(t, knob.angleForValue(60), r.active.EndAngle)
+	assert.Equal(t, knob.angleForValue(20), r.reference.EndAngle)
+}
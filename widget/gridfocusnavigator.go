@@ -0,0 +1,93 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+)
+
+// GridFocusNavigator maps arrow key presses onto focus movement within a grid of
+// focusable cells laid out in rows of a fixed column count. Movement clamps at the
+// grid edges, and Home/End jump to the start and end of the current row. It captures
+// the pattern that grid based widgets otherwise implement by hand.
+//
+// Since: 2.7
+type GridFocusNavigator struct {
+	columns int
+	cells   []fyne.Focusable
+	current int
+}
+
+// NewGridFocusNavigator returns a navigator over the given cells arranged in rows
+// of the stated column count. The first cell starts as current.
+//
+// Since: 2.7
+func NewGridFocusNavigator(columns int, cells ...fyne.Focusable) *GridFocusNavigator {
+	if columns < 1 {
+		columns = 1
+	}
+	return &GridFocusNavigator{columns: columns, cells: cells}
+}
+
+// Current returns the focusable cell the navigator currently points at,
+// or nil when it has no cells.
+//
+// Since: 2.7
+func (g *GridFocusNavigator) Current() fyne.Focusable {
+	if len(g.cells) == 0 {
+		return nil
+	}
+	return g.cells[g.current]
+}
+
+// SetCurrent points the navigator at the given cell index, ignoring indexes
+// outside the grid.
+//
+// Since: 2.7
+func (g *GridFocusNavigator) SetCurrent(index int) {
+	if index < 0 || index >= len(g.cells) {
+		return
+	}
+	g.current = index
+}
+
+// Handle moves the current cell for the given key, reporting whether the key was a
+// navigation key it understands. Use Current to find the cell to focus afterwards.
+//
+// Since: 2.7
+func (g *GridFocusNavigator) Handle(key fyne.KeyName) bool {
+	if len(g.cells) == 0 {
+		return false
+	}
+
+	row, col := g.current/g.columns, g.current%g.columns
+	next := g.current
+	switch key {
+	case fyne.KeyLeft:
+		if col > 0 {
+			next--
+		}
+	case fyne.KeyRight:
+		if col < g.columns-1 && g.current+1 < len(g.cells) {
+			next++
+		}
+	case fyne.KeyUp:
+		if row > 0 {
+			next -= g.columns
+		}
+	case fyne.KeyDown:
+		if g.current+g.columns < len(g.cells) {
+			next += g.columns
+		}
+	case fyne.KeyHome:
+		next = row * g.columns
+	case fyne.KeyEnd:
+		next = row*g.columns + g.columns - 1
+		if next >= len(g.cells) {
+			next = len(g.cells) - 1
+		}
+	default:
+		return false
+	}
+
+	g.current = next
+	return true
+}
@@ -0,0 +1,60 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGridFocusNavigator_Handle(t *testing.T) {
+	cells := make([]fyne.Focusable, 9)
+	for i := range cells {
+		cells[i] = NewEntry()
+	}
+	nav := NewGridFocusNavigator(3, cells...)
+	assert.Equal(t, cells[0], nav.Current())
+
+	assert.True(t, nav.Handle(fyne.KeyRight))
+	assert.Equal(t, cells[1], nav.Current())
+
+	assert.True(t, nav.Handle(fyne.KeyDown))
+	assert.Equal(t, cells[4], nav.Current())
+
+	assert.True(t, nav.Handle(fyne.KeyLeft))
+	assert.Equal(t, cells[3], nav.Current())
+
+	assert.True(t, nav.Handle(fyne.KeyUp))
+	assert.Equal(t, cells[0], nav.Current())
+
+	// other keys are not navigation
+	assert.False(t, nav.Handle(fyne.KeyEscape))
+}
+
+func TestGridFocusNavigator_EdgeClamping(t *testing.T) {
+	cells := make([]fyne.Focusable, 9)
+	for i := range cells {
+		cells[i] = NewEntry()
+	}
+	nav := NewGridFocusNavigator(3, cells...)
+
+	// movement off the edges stays put but is still handled
+	assert.True(t, nav.Handle(fyne.KeyLeft))
+	assert.Equal(t, cells[0], nav.Current())
+	assert.True(t, nav.Handle(fyne.KeyUp))
+	assert.Equal(t, cells[0], nav.Current())
+
+	nav.SetCurrent(8)
+	assert.True(t, nav.Handle(fyne.KeyRight))
+	assert.Equal(t, cells[8], nav.Current())
+	assert.True(t, nav.Handle(fyne.KeyDown))
+	assert.Equal(t, cells[8], nav.Current())
+
+	// Home and End move within the current row
+	nav.SetCurrent(4)
+	assert.True(t, nav.Handle(fyne.KeyHome))
+	assert.Equal(t, cells[3], nav.Current())
+	assert.True(t, nav.Handle(fyne.KeyEnd))
+	assert.Equal(t, cells[5], nav.Current())
+}
@@ -0,0 +1,27 @@
+package widget
+
+// InputModality identifies the kind of input a user most recently drove a widget
+// with, so focus decorations can be shown only when they help - keyboard users
+// need the ring to see where focus sits, while pointer users just clicked there.
+//
+// Since: 2.7
+type InputModality int
+
+const (
+	// ModalityKeyboard is the zero value, so focus gained without a preceding
+	// pointer event - tabbing into a widget, or programmatic focus - is treated
+	// as keyboard driven and shows the focus decoration.
+	ModalityKeyboard InputModality = iota
+	// ModalityPointer is recorded when a tap or mouse press arrives, hiding the
+	// focus decoration for focus the pointer itself just placed.
+	ModalityPointer
+)
+
+// FocusRingVisible reports whether a focus decoration should draw for a widget in
+// the given focus state, following the last input modality the widget recorded.
+// This is the "focus visible" behaviour keyboard users expect from the web.
+//
+// Since: 2.7
+func FocusRingVisible(focused bool, last InputModality) bool {
+	return focused && last == ModalityKeyboard
+}
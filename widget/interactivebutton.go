@@ -0,0 +1,94 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+)
+
+var (
+	_ desktop.Cursorable = (*InteractiveButton)(nil)
+	_ desktop.Hoverable  = (*InteractiveButton)(nil)
+	_ desktop.Keyable    = (*InteractiveButton)(nil)
+	_ desktop.Mouseable  = (*InteractiveButton)(nil)
+)
+
+// InteractiveButton is a Button that exposes the desktop interaction interfaces as
+// optional callback fields, so apps can observe hover, raw mouse and key events
+// without writing a custom widget. Unset callbacks are no-ops.
+//
+// Since: 2.7
+type InteractiveButton struct {
+	Button
+
+	OnMouseIn    func(*desktop.MouseEvent) `json:"-"`
+	OnMouseMoved func(*desktop.MouseEvent) `json:"-"`
+	OnMouseOut   func()                    `json:"-"`
+	OnMouseDown  func(*desktop.MouseEvent) `json:"-"`
+	OnMouseUp    func(*desktop.MouseEvent) `json:"-"`
+	OnKeyDown    func(*fyne.KeyEvent)      `json:"-"`
+	OnKeyUp      func(*fyne.KeyEvent)      `json:"-"`
+}
+
+// NewInteractiveButton returns a button with the given label and tap handler whose
+// desktop events can be observed through its callback fields.
+//
+// Since: 2.7
+func NewInteractiveButton(label string, tapped func()) *InteractiveButton {
+	button := &InteractiveButton{}
+	button.Text = label
+	button.OnTapped = tapped
+	button.ExtendBaseWidget(button)
+	return button
+}
+
+// MouseIn is called when a desktop pointer enters the button.
+func (b *InteractiveButton) MouseIn(e *desktop.MouseEvent) {
+	b.Button.MouseIn(e)
+	if b.OnMouseIn != nil {
+		b.OnMouseIn(e)
+	}
+}
+
+// MouseMoved is called when a desktop pointer hovers over the button.
+func (b *InteractiveButton) MouseMoved(e *desktop.MouseEvent) {
+	b.Button.MouseMoved(e)
+	if b.OnMouseMoved != nil {
+		b.OnMouseMoved(e)
+	}
+}
+
+// MouseOut is called when a desktop pointer exits the button.
+func (b *InteractiveButton) MouseOut() {
+	b.Button.MouseOut()
+	if b.OnMouseOut != nil {
+		b.OnMouseOut()
+	}
+}
+
+// MouseDown is called when a mouse button is pressed over the button.
+func (b *InteractiveButton) MouseDown(e *desktop.MouseEvent) {
+	if b.OnMouseDown != nil {
+		b.OnMouseDown(e)
+	}
+}
+
+// MouseUp is called when a mouse button is released over the button.
+func (b *InteractiveButton) MouseUp(e *desktop.MouseEvent) {
+	if b.OnMouseUp != nil {
+		b.OnMouseUp(e)
+	}
+}
+
+// KeyDown is called when a key is pressed while the button has focus.
+func (b *InteractiveButton) KeyDown(e *fyne.KeyEvent) {
+	if b.OnKeyDown != nil {
+		b.OnKeyDown(e)
+	}
+}
+
+// KeyUp is called when a key is released while the button has focus.
+func (b *InteractiveButton) KeyUp(e *fyne.KeyEvent) {
+	if b.OnKeyUp != nil {
+		b.OnKeyUp(e)
+	}
+}
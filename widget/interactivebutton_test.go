@@ -0,0 +1,64 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInteractiveButton_Callbacks(t *testing.T) {
+	button := NewInteractiveButton("Try me", nil)
+
+	var events []string
+	button.OnMouseIn = func(_ *desktop.MouseEvent) {
+		events = append(events, "in")
+	}
+	button.OnMouseMoved = func(_ *desktop.MouseEvent) {
+		events = append(events, "moved")
+	}
+	button.OnMouseOut = func() {
+		events = append(events, "out")
+	}
+	button.OnMouseDown = func(_ *desktop.MouseEvent) {
+		events = append(events, "down")
+	}
+	button.OnMouseUp = func(_ *desktop.MouseEvent) {
+		events = append(events, "up")
+	}
+	button.OnKeyDown = func(e *fyne.KeyEvent) {
+		events = append(events, "key down "+string(e.Name))
+	}
+	button.OnKeyUp = func(e *fyne.KeyEvent) {
+		events = append(events, "key up "+string(e.Name))
+	}
+
+	button.MouseIn(&desktop.MouseEvent{})
+	button.MouseMoved(&desktop.MouseEvent{})
+	button.MouseDown(&desktop.MouseEvent{})
+	button.MouseUp(&desktop.MouseEvent{})
+	button.MouseOut()
+	button.KeyDown(&fyne.KeyEvent{Name: fyne.KeySpace})
+	button.KeyUp(&fyne.KeyEvent{Name: fyne.KeySpace})
+
+	assert.Equal(t, []string{"in", "moved", "down", "up", "out", "key down Space", "key up Space"}, events)
+}
+
+func TestInteractiveButton_NilCallbacks(t *testing.T) {
+	button := NewInteractiveButton("Quiet", nil)
+
+	// unset callbacks must be safe no-ops
+	button.MouseIn(&desktop.MouseEvent{})
+	button.MouseMoved(&desktop.MouseEvent{})
+	button.MouseDown(&desktop.MouseEvent{})
+	button.MouseUp(&desktop.MouseEvent{})
+	button.MouseOut()
+	button.KeyDown(&fyne.KeyEvent{Name: fyne.KeyEscape})
+	button.KeyUp(&fyne.KeyEvent{Name: fyne.KeyEscape})
+
+	assert.Equal(t, desktop.DefaultCursor, button.Cursor())
+	button.HoverCursor = desktop.PointerCursor
+	assert.Equal(t, desktop.PointerCursor, button.Cursor())
+}
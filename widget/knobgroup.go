@@ -0,0 +1,87 @@
+package widget
+
+// KnobGroup coordinates a set of RotatingKnob widgets so their changes can be observed
+// in one place, for example a mixer master view aggregating channel knobs.
+// The group does not lay the knobs out - place them in any container as usual.
+//
+// Since: 2.7
+type KnobGroup struct {
+	// OnAnyChange is called when the value of any member knob changes, passing the
+	// knob that changed together with its new value.
+	OnAnyChange func(knob *RotatingKnob, value float64) `json:"-"`
+
+	// OnChanged is called when the value of any member knob changes, passing the
+	// knob's index in the group rather than the knob itself - the natural shape for
+	// channel-indexed state such as a mixer level slice.
+	//
+	// Since: 2.7
+	OnChanged func(index int, value float64) `json:"-"`
+
+	knobs []*RotatingKnob
+}
+
+// NewKnobGroup returns a group observing the given knobs.
+//
+// Since: 2.7
+func NewKnobGroup(knobs ...*RotatingKnob) *KnobGroup {
+	group := &KnobGroup{}
+	for _, knob := range knobs {
+		group.Add(knob)
+	}
+	return group
+}
+
+// Add appends a knob to this group, so that future value changes notify OnAnyChange
+// and OnChanged. Any OnChanged callback already set on the knob will still be
+// called first.
+//
+// Since: 2.7
+func (g *KnobGroup) Add(knob *RotatingKnob) {
+	index := len(g.knobs)
+	g.knobs = append(g.knobs, knob)
+
+	existing := knob.OnChanged
+	knob.OnChanged = func(value float64) {
+		if existing != nil {
+			existing(value)
+		}
+		if g.OnAnyChange != nil {
+			g.OnAnyChange(knob, value)
+		}
+		if g.OnChanged != nil {
+			g.OnChanged(index, value)
+		}
+	}
+}
+
+// Knobs returns the knobs currently observed by this group.
+//
+// Since: 2.7
+func (g *KnobGroup) Knobs() []*RotatingKnob {
+	return g.knobs
+}
+
+// Values returns the current value of every knob in the group, in index order.
+//
+// Since: 2.7
+func (g *KnobGroup) Values() []float64 {
+	values := make([]float64, len(g.knobs))
+	for i, knob := range g.knobs {
+		values[i] = knob.Value()
+	}
+	return values
+}
+
+// SetValues applies the given values to the knobs in index order, clamping each to
+// its knob's range like SetValue. Extra values are ignored and knobs beyond the end
+// of the slice keep their current value.
+//
+// Since: 2.7
+func (g *KnobGroup) SetValues(values []float64) {
+	for i, knob := range g.knobs {
+		if i >= len(values) {
+			break
+		}
+		knob.SetValue(values[i])
+	}
+}
@@ -0,0 +1,79 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKnobGroup_OnAnyChange(t *testing.T) {
+	first := NewRotatingKnob(0, 100)
+	second := NewRotatingKnob(0, 100)
+	group := NewKnobGroup(first, second)
+
+	var changed *RotatingKnob
+	var changedTo float64
+	group.OnAnyChange = func(knob *RotatingKnob, value float64) {
+		changed = knob
+		changedTo = value
+	}
+
+	second.SetValue(60)
+	assert.Equal(t, second, changed)
+	assert.Equal(t, 60.0, changedTo)
+
+	first.SetValue(10)
+	assert.Equal(t, first, changed)
+	assert.Equal(t, 10.0, changedTo)
+}
+
+func TestKnobGroup_PreservesOnChanged(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	own := 0
+	knob.OnChanged = func(_ float64) {
+		own++
+	}
+
+	group := NewKnobGroup()
+	group.Add(knob)
+	groupChanges := 0
+	group.OnAnyChange = func(_ *RotatingKnob, _ float64) {
+		groupChanges++
+	}
+
+	knob.SetValue(42)
+	assert.Equal(t, 1, own)
+	assert.Equal(t, 1, groupChanges)
+	assert.Len(t, group.Knobs(), 1)
+}
+
+func TestKnobGroup_OnChangedIndex(t *testing.T) {
+	first := NewRotatingKnob(0, 100)
+	second := NewRotatingKnob(0, 100)
+	group := NewKnobGroup(first, second)
+
+	index, value := -1, 0.0
+	group.OnChanged = func(i int, v float64) {
+		index, value = i, v
+	}
+
+	second.SetValue(60)
+	assert.Equal(t, 1, index)
+	assert.Equal(t, 60.0, value)
+
+	first.SetValue(10)
+	assert.Equal(t, 0, index)
+	assert.Equal(t, 10.0, value)
+}
+
+func TestKnobGroup_Values(t *testing.T) {
+	first := NewRotatingKnob(0, 100)
+	second := NewRotatingKnob(0, 10)
+	group := NewKnobGroup(first, second)
+
+	group.SetValues([]float64{30, 70, 99})
+	assert.Equal(t, []float64{30, 10}, group.Values(), "values clamp to each knob's range, extras are ignored")
+
+	group.SetValues([]float64{5})
+	assert.Equal(t, []float64{5, 10}, group.Values(), "knobs beyond the slice keep their value")
+}
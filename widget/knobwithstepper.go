@@ -0,0 +1,84 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+)
+
+var _ fyne.Disableable = (*KnobWithStepper)(nil)
+
+// KnobWithStepper is a composite control pairing a RotatingKnob with small
+// increment and decrement buttons, for users who prefer clicking precise steps
+// over dragging the dial. The buttons move the value by the knob's Step and
+// follow the knob's disabled state.
+//
+// Since: 2.7
+type KnobWithStepper struct {
+	BaseWidget
+
+	// Knob is the dial at the centre of the control. Configure its range, step and
+	// callbacks directly.
+	Knob *RotatingKnob
+
+	decrement *Button
+	increment *Button
+}
+
+// NewKnobWithStepper returns a knob with stepper buttons that adjusts a value
+// between the given minimum and maximum.
+//
+// Since: 2.7
+func NewKnobWithStepper(min, max float64) *KnobWithStepper {
+	s := &KnobWithStepper{Knob: NewRotatingKnob(min, max)}
+	s.decrement = NewButtonWithIcon("", theme.ContentRemoveIcon(), func() {
+		s.Knob.SetValue(s.Knob.Value() - s.Knob.Step)
+	})
+	s.increment = NewButtonWithIcon("", theme.ContentAddIcon(), func() {
+		s.Knob.SetValue(s.Knob.Value() + s.Knob.Step)
+	})
+	s.ExtendBaseWidget(s)
+	return s
+}
+
+// CreateRenderer links this widget to its renderer.
+func (s *KnobWithStepper) CreateRenderer() fyne.WidgetRenderer {
+	s.ExtendBaseWidget(s)
+	content := &fyne.Container{
+		Layout:  layout.NewBorderLayout(nil, nil, s.decrement, s.increment),
+		Objects: []fyne.CanvasObject{s.decrement, s.increment, s.Knob},
+	}
+	return NewSimpleRenderer(content)
+}
+
+// Disable greys out the knob and both stepper buttons.
+func (s *KnobWithStepper) Disable() {
+	s.Knob.Disable()
+	s.decrement.Disable()
+	s.increment.Disable()
+}
+
+// Enable restores the knob and both stepper buttons to their usable state.
+func (s *KnobWithStepper) Enable() {
+	s.Knob.Enable()
+	s.decrement.Enable()
+	s.increment.Enable()
+}
+
+// Disabled reports whether this control is currently disabled.
+func (s *KnobWithStepper) Disabled() bool {
+	return s.Knob.Disabled()
+}
+
+// Refresh redraws the control, keeping the stepper buttons in step with the
+// knob's disabled state for callers that toggled the knob directly.
+func (s *KnobWithStepper) Refresh() {
+	if s.Knob.Disabled() {
+		s.decrement.Disable()
+		s.increment.Disable()
+	} else {
+		s.decrement.Enable()
+		s.increment.Enable()
+	}
+	s.BaseWidget.Refresh()
+}
@@ -0,0 +1,45 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKnobWithStepper_Buttons(t *testing.T) {
+	stepper := NewKnobWithStepper(0, 10)
+	stepper.Knob.Step = 2
+
+	ended := 0
+	stepper.Knob.OnChangeEnded = func(_ float64) {
+		ended++
+	}
+
+	stepper.increment.Tapped(nil)
+	assert.Equal(t, 2.0, stepper.Knob.Value())
+	assert.Equal(t, 1, ended)
+
+	stepper.decrement.Tapped(nil)
+	assert.Equal(t, 0.0, stepper.Knob.Value())
+	assert.Equal(t, 2, ended)
+
+	// stepping clamps at the range ends
+	stepper.decrement.Tapped(nil)
+	assert.Equal(t, 0.0, stepper.Knob.Value())
+}
+
+func TestKnobWithStepper_Disable(t *testing.T) {
+	stepper := NewKnobWithStepper(0, 10)
+
+	stepper.Disable()
+	assert.True(t, stepper.Disabled())
+	assert.True(t, stepper.increment.Disabled())
+
+	stepper.Enable()
+	assert.False(t, stepper.increment.Disabled())
+
+	// disabling the knob directly catches up on refresh
+	stepper.Knob.Disable()
+	stepper.Refresh()
+	assert.True(t, stepper.decrement.Disabled())
+}
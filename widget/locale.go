@@ -92,6 +92,30 @@ var localeSettings = map[string]localeSetting{
 	},
 }
 
+var systemLocale = lang.SystemLocale // used in tests
+
+// rtlLanguages lists the language codes of scripts written right to left.
+var rtlLanguages = map[string]bool{
+	"ar": true, // Arabic
+	"dv": true, // Divehi
+	"fa": true, // Persian
+	"he": true, // Hebrew
+	"ps": true, // Pashto
+	"sd": true, // Sindhi
+	"ug": true, // Uyghur
+	"ur": true, // Urdu
+	"yi": true, // Yiddish
+}
+
+// isLocaleRTL reports whether the given locale uses a right-to-left script.
+func isLocaleRTL(l fyne.Locale) bool {
+	code := l.LanguageString()
+	if pos := strings.Index(code, "-"); pos != -1 {
+		code = code[:pos]
+	}
+	return rtlLanguages[code]
+}
+
 func getLocaleDateFormat() string {
 	s := lookupLocaleSetting(lang.SystemLocale())
 	if d := s.dateFormat; d != "" {
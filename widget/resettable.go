@@ -0,0 +1,11 @@
+package widget
+
+// Resettable is implemented by widgets that can restore themselves to a default
+// state. A generic "reset all" button can iterate mixed controls through this
+// interface without type switches.
+//
+// Since: 2.7
+type Resettable interface {
+	// Reset restores the widget to its default state.
+	Reset()
+}
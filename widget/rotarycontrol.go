@@ -0,0 +1,154 @@
+package widget
+
+import (
+	"math"
+	"strconv"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/internal/widget"
+	"fyne.io/fyne/v2/theme"
+)
+
+var (
+	_ fyne.Draggable   = (*RotaryControl)(nil)
+	_ fyne.Tappable    = (*RotaryControl)(nil)
+	_ fyne.Disableable = (*RotaryControl)(nil)
+	_ Resettable       = (*RotaryControl)(nil)
+)
+
+// RotaryControl is a ring shaped control that adjusts a value between two fixed bounds
+// by dragging a thumb around an annular track.
+// It shares its value, angle and gesture handling with RotatingKnob, differing only in
+// presentation: a thumb riding the ring instead of an indicator over a central dot.
+//
+// Since: 2.7
+type RotaryControl struct {
+	RotatingKnob
+}
+
+// NewRotaryControl returns a rotary control that adjusts a value between the given minimum and maximum.
+//
+// Since: 2.7
+func NewRotaryControl(min, max float64) *RotaryControl {
+	control := &RotaryControl{}
+	control.Min = min
+	control.Max = max
+	control.Step = 1
+	control.AbsoluteDrag = true
+	control.StartAngle = -135
+	control.EndAngle = 135
+	control.value = min
+	control.ExtendBaseWidget(control)
+	return control
+}
+
+// MarkupName identifies this widget in rendered test markup, keeping golden files
+// unambiguous however the implementation is shared.
+//
+// Since: 2.7
+func (c *RotaryControl) MarkupName() string {
+	return "RotaryControl"
+}
+
+// AccessibilityNode describes this control for accessibility testing, reporting the
+// role "rotary control" together with the current value.
+//
+// Since: 2.7
+func (c *RotaryControl) AccessibilityNode() (role, name, value string) {
+	return "rotary control", c.StatusName, strconv.FormatFloat(c.value, 'f', -1, 64)
+}
+
+// MinSize returns the size that this widget should not shrink below.
+func (c *RotaryControl) MinSize() fyne.Size {
+	c.ExtendBaseWidget(c)
+	return c.BaseWidget.MinSize()
+}
+
+// CreateRenderer links this widget to its renderer.
+func (c *RotaryControl) CreateRenderer() fyne.WidgetRenderer {
+	c.ExtendBaseWidget(c)
+	th := c.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+
+	track := canvas.NewDoughnutArc(c.StartAngle, c.EndAngle, th.Color(theme.ColorNameInputBackground, v))
+	active := canvas.NewDoughnutArc(c.StartAngle, c.StartAngle, th.Color(theme.ColorNameForeground, v))
+	track.RingMode, active.RingMode = true, true // clean edges at any cutout ratio
+	thumb := &canvas.Circle{FillColor: th.Color(theme.ColorNameForeground, v)}
+
+	r := &rotaryControlRenderer{widget.NewBaseRenderer([]fyne.CanvasObject{track, active, thumb}), track, active, thumb, c}
+	r.Refresh() // prepare for first draw
+	return r
+}
+
+type rotaryControlRenderer struct {
+	widget.BaseRenderer
+	track   *canvas.Arc
+	active  *canvas.Arc
+	thumb   *canvas.Circle
+	control *RotaryControl
+}
+
+// MinSize calculates the minimum size of the control.
+func (r *rotaryControlRenderer) MinSize() fyne.Size {
+	th := r.control.Theme()
+	diameter := th.Size(theme.SizeNameInlineIcon)*2 + th.Size(theme.SizeNameInnerPadding)*2
+	return fyne.NewSquareSize(fyne.Max(diameter, r.control.MinDiameter))
+}
+
+// Layout the components of the control.
+func (r *rotaryControlRenderer) Layout(size fyne.Size) {
+	th := r.control.Theme()
+
+	rx, ry := r.control.radii()
+	radius := fyne.Min(rx, ry) // canvas.Arc rasterizes a circle of the smaller radius
+	center := fyne.NewPos(size.Width/2, size.Height/2)
+
+	// canvas.Arc is centred on its position
+	r.track.Move(center)
+	r.track.Resize(fyne.NewSquareSize(radius * 2))
+	r.active.Move(center)
+	r.active.Resize(fyne.NewSquareSize(radius * 2))
+
+	thumbDiameter := th.Size(theme.SizeNameInlineIcon) - 4 // match slider thumbs
+	angle := float64(r.control.angleForValue(r.control.value)) * math.Pi / 180
+	thumbCenter := fyne.NewPos(
+		center.X+float32(math.Sin(angle))*radius*0.75,
+		center.Y-float32(math.Cos(angle))*radius*0.75)
+	r.thumb.Move(fyne.NewPos(thumbCenter.X-thumbDiameter/2, thumbCenter.Y-thumbDiameter/2))
+	r.thumb.Resize(fyne.NewSquareSize(thumbDiameter))
+}
+
+// Refresh updates the control state for drawing.
+func (r *rotaryControlRenderer) Refresh() {
+	th := r.control.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+
+	foreground := th.Color(theme.ColorNameForeground, v)
+	trackColor := th.Color(theme.ColorNameInputBackground, v)
+	if r.control.disabled {
+		foreground = th.Color(theme.ColorNameDisabled, v)
+		trackColor = th.Color(theme.ColorNameDisabledButton, v)
+	}
+
+	start, end := r.control.angleRange()
+	r.track.FillColor = trackColor
+	r.track.StartAngle = start
+	r.track.EndAngle = end
+
+	r.active.FillColor = foreground
+	r.active.StartAngle = start
+	r.active.EndAngle = r.control.angleForValue(r.control.value)
+
+	r.thumb.FillColor = foreground
+	if (r.control.HideIndicatorAtMin && r.control.value == r.control.Min) ||
+		(r.control.HideIndicatorAtMax && r.control.value == r.control.Max) {
+		r.thumb.Hide()
+	} else {
+		r.thumb.Show()
+	}
+
+	r.control.clampValueToRange()
+	r.Layout(r.control.Size())
+	canvas.Refresh(r.control.super())
+}
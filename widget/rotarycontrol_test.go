@@ -0,0 +1,180 @@
+package widget
+
+import (
+	"math"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRotaryControl_SetValue(t *testing.T) {
+	control := NewRotaryControl(0, 100)
+	assert.Equal(t, 0.0, control.Value())
+
+	control.SetValue(75)
+	assert.Equal(t, 75.0, control.Value())
+}
+
+func TestRotaryControl_SetValue_Clamped(t *testing.T) {
+	control := NewRotaryControl(0, 10)
+
+	control.SetValue(15)
+	assert.Equal(t, 10.0, control.Value())
+
+	control.SetValue(-1)
+	assert.Equal(t, 0.0, control.Value())
+}
+
+func TestRotaryControl_Dragged(t *testing.T) {
+	control := NewRotaryControl(0, 100)
+	control.Resize(fyne.NewSize(100, 100))
+
+	// drag to the top of the control, the middle of the default sweep
+	control.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(50, 10)}})
+	assert.Equal(t, 50.0, control.Value())
+}
+
+func TestRotaryControl_OnChangeEnded(t *testing.T) {
+	control := NewRotaryControl(0, 100)
+	control.Resize(fyne.NewSize(100, 100))
+
+	ended := 0
+	control.OnChangeEnded = func(_ float64) {
+		ended++
+	}
+	control.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(50, 10)}})
+	assert.Equal(t, 0, ended)
+
+	control.DragEnd()
+	assert.Equal(t, 1, ended)
+}
+
+func TestRotaryControl_HideIndicatorAtMin(t *testing.T) {
+	control := NewRotaryControl(0, 100)
+	control.HideIndicatorAtMin = true
+	render := test.TempWidgetRenderer(t, control).(*rotaryControlRenderer)
+
+	assert.Equal(t, 0.0, control.Value())
+	assert.False(t, render.thumb.Visible())
+
+	control.SetValue(30)
+	assert.True(t, render.thumb.Visible())
+}
+
+func TestRotaryControl_ZeroSweep(t *testing.T) {
+	control := NewRotaryControl(0, 100)
+	control.StartAngle = 90
+	control.EndAngle = 90
+	control.Resize(fyne.NewSize(100, 100))
+	render := test.TempWidgetRenderer(t, control).(*rotaryControlRenderer)
+
+	// a zero sweep falls back to a full rotation rather than producing NaN ratios
+	control.SetValue(50)
+	assert.Equal(t, 50.0, control.Value())
+	assert.Equal(t, float32(270), render.active.EndAngle)
+	assert.False(t, math.IsNaN(float64(render.thumb.Position().X)))
+	assert.False(t, math.IsNaN(float64(render.thumb.Position().Y)))
+
+	control.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(50, 90)}})
+	assert.False(t, math.IsNaN(control.Value()))
+}
+
+func TestRotaryControl_AllowEllipse(t *testing.T) {
+	control := NewRotaryControl(0, 100)
+	control.AllowEllipse = true
+	control.Resize(fyne.NewSize(200, 100))
+	render := test.TempWidgetRenderer(t, control).(*rotaryControlRenderer)
+	render.Layout(control.Size())
+
+	// the drawn arc stays a circle of the smaller radius, matching the rasterizer
+	assert.Equal(t, render.track.Size().Width, render.track.Size().Height)
+
+	// but dragging at the wide eastern edge still maps to the angular position
+	control.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(196, 50)}})
+	assert.InDelta(t, 83.0, control.Value(), 1)
+}
+
+func TestRotaryControl_OnDelta(t *testing.T) {
+	control := NewRotaryControl(0, 100)
+	control.Resize(fyne.NewSize(100, 100))
+
+	var deltas []float64
+	control.OnDelta = func(delta float64) {
+		deltas = append(deltas, delta)
+	}
+
+	control.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(50, 10)}})
+	control.SetValue(30)
+	assert.Equal(t, []float64{50, -20}, deltas)
+}
+
+func TestRotaryControl_DragThreshold(t *testing.T) {
+	control := NewRotaryControl(0, 100)
+	control.DragThreshold = 5
+	control.Resize(fyne.NewSize(100, 100))
+	control.SetValue(50)
+
+	// jitter below the threshold leaves the value alone
+	control.Dragged(&fyne.DragEvent{
+		PointEvent: fyne.PointEvent{Position: fyne.NewPos(51, 10)},
+		Dragged:    fyne.NewDelta(1, 0)})
+	assert.Equal(t, 50.0, control.Value())
+
+	// once the pointer travels past the threshold the value tracks normally
+	control.Dragged(&fyne.DragEvent{
+		PointEvent: fyne.PointEvent{Position: fyne.NewPos(90, 50)},
+		Dragged:    fyne.NewDelta(39, 40)})
+	assert.Equal(t, 83.0, control.Value())
+
+	// a fresh drag starts another dead zone
+	control.DragEnd()
+	control.Dragged(&fyne.DragEvent{
+		PointEvent: fyne.PointEvent{Position: fyne.NewPos(89, 51)},
+		Dragged:    fyne.NewDelta(-1, 1)})
+	assert.Equal(t, 83.0, control.Value())
+}
+
+func TestRotaryControl_RequestFocus(t *testing.T) {
+	control := NewRotaryControl(0, 100)
+	w := test.NewTempWindow(t, control)
+
+	control.RequestFocus(w.Canvas())
+	assert.Equal(t, fyne.Focusable(control), w.Canvas().Focused())
+	assert.True(t, control.focused)
+
+	// a nil canvas is a harmless no-op
+	control.RequestFocus(nil)
+}
+
+func TestRotaryControl_IntegerOnly(t *testing.T) {
+	control := NewRotaryControl(0, 11)
+	control.Step = 0.25
+	control.IntegerOnly = true
+	control.Resize(fyne.NewSize(100, 100))
+
+	values := []float64{}
+	control.OnChanged = func(value float64) {
+		values = append(values, value)
+	}
+
+	control.SetValue(5.4)
+	assert.Equal(t, 5.0, control.Value())
+	control.SetValue(5.5)
+	assert.Equal(t, 6.0, control.Value())
+
+	// dragging reports whole numbers too
+	control.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(90, 50)}})
+	assert.Equal(t, 9.0, control.Value())
+	assert.Equal(t, []float64{5, 6, 9}, values)
+
+	// values round inwards at the boundaries rather than leaving the range
+	fractional := NewRotaryControl(0.5, 10.5)
+	fractional.IntegerOnly = true
+	fractional.SetValue(10.5)
+	assert.Equal(t, 10.0, fractional.Value())
+	fractional.SetValue(0.5)
+	assert.Equal(t, 1.0, fractional.Value())
+}
@@ -0,0 +1,2480 @@
+package widget
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/driver/mobile"
+	"fyne.io/fyne/v2/internal/widget"
+	"fyne.io/fyne/v2/theme"
+)
+
+var (
+	_ fyne.Draggable      = (*RotatingKnob)(nil)
+	_ fyne.Focusable      = (*RotatingKnob)(nil)
+	_ fyne.Tappable       = (*RotatingKnob)(nil)
+	_ fyne.DoubleTappable = (*RotatingKnob)(nil)
+	_ fyne.Disableable    = (*RotatingKnob)(nil)
+	_ fyne.Scrollable     = (*RotatingKnob)(nil)
+	_ Resettable          = (*RotatingKnob)(nil)
+	_ fyne.Shortcutable   = (*RotatingKnob)(nil)
+	_ desktop.Hoverable   = (*RotatingKnob)(nil)
+	_ desktop.Mouseable   = (*RotatingKnob)(nil)
+	_ desktop.Keyable     = (*RotatingKnob)(nil)
+	_ mobile.Touchable    = (*RotatingKnob)(nil)
+)
+
+const defaultKnobTickCount = 11
+
+// defaultKnobCompactThreshold is the knob diameter below which decoration is hidden
+// when CompactThreshold is left at zero.
+const defaultKnobCompactThreshold = 40
+
+// knobFinePressDelay is how long a touch must be held before a drag on a mobile
+// device enters fine-adjust mode for that gesture.
+const knobFinePressDelay = 500 * time.Millisecond
+
+// knobFineModeScale is the fraction of each drag movement applied in fine-adjust mode.
+const knobFineModeScale = 0.1
+
+// defaultKnobHybridThreshold is the fraction of the knob radius inside which a
+// HybridDrag gesture makes fine adjustments, when HybridThreshold is unset.
+const defaultKnobHybridThreshold = 0.5
+
+// knobHybridFineRange is how many vertical pixels a hybrid fine drag must travel
+// to sweep the whole range, setting the gearing of the inner region.
+const knobHybridFineRange = 500.0
+
+var knobOnMobile = func() bool { return fyne.CurrentDevice().IsMobile() } // used in tests
+
+// RotatingKnob is a circular control that adjusts a value between two fixed bounds
+// by rotating an indicator around a central dot, much like a volume knob on audio equipment.
+// The swept angle between StartAngle and EndAngle maps linearly onto the value range.
+//
+// Since: 2.7
+type RotatingKnob struct {
+	BaseWidget
+
+	Min  float64
+	Max  float64
+	Step float64
+
+	// StartAngle and EndAngle define the sweep of the knob in degrees, using the same
+	// convention as canvas.Arc (0 is top, positive is clockwise). StartAngle maps to Min
+	// and EndAngle maps to Max.
+	StartAngle float32
+	EndAngle   float32
+
+	// TickCount is the number of tick marks drawn around the knob. Zero hides the ticks.
+	TickCount int
+
+	// TickPositions places tick marks at these exact values between Min and Max,
+	// overriding TickCount's uniform spacing - denser towards one end for an audio
+	// taper, for example. An empty slice keeps the uniform behaviour.
+	//
+	// Since: 2.7
+	TickPositions []float64
+
+	// Notch marks a preferred detent value with a short contrasting radial line
+	// across the track - the centre of a balance knob, for example. Pair it with
+	// Step or Steps snapping so the value clicks into the marked position. Nil
+	// draws no notch.
+	//
+	// Since: 2.7
+	Notch *float64
+
+	// Steps, when non-empty, restricts the knob to the enumerated values in ascending
+	// order; dragging, scrolling and SetValue snap to the nearest entry and keyboard
+	// arrows move to the adjacent entry regardless of Step. Useful for mode selectors.
+	//
+	// Since: 2.7
+	Steps []float64
+	// StepLabels optionally labels the Steps entries, drawn beside the matching positions.
+	//
+	// Since: 2.7
+	StepLabels []string
+
+	// Rounding selects how dragged, tapped and scrolled values are rounded onto Step
+	// multiples from Min, so detent behaviour can match the host application's
+	// conventions. The zero value keeps the historical half-down rounding.
+	//
+	// Since: 2.7
+	Rounding KnobRounding
+
+	// TickColor overrides the colour of the tick marks, for themes where the default
+	// shadow-based colour lacks contrast. Nil keeps the theme default.
+	//
+	// Since: 2.7
+	TickColor color.Color
+
+	// WedgeColor, when set, fills the pie wedge swept from StartAngle to the current
+	// value's angle beneath the arc. Nil draws no wedge.
+	//
+	// Since: 2.7
+	WedgeColor color.Color
+
+	// WedgeGradient fades the wedge from the first colour at StartAngle to the second
+	// at the current value's angle. Both entries must be set for the gradient to draw;
+	// it takes precedence over the flat WedgeColor.
+	//
+	// Since: 2.7
+	WedgeGradient [2]color.Color
+
+	// ShowGap draws a faint arc across the unused angular region between EndAngle
+	// and StartAngle, so users can see that a non-360 knob is not full-circle.
+	//
+	// Since: 2.7
+	ShowGap bool
+
+	// GapColor overrides the colour of the gap arc drawn by ShowGap. When nil a
+	// very low-alpha version of the disabled colour is used.
+	//
+	// Since: 2.7
+	GapColor color.Color
+
+	// DisabledColor overrides the foreground colour used while the knob is disabled,
+	// for example a locked red. Nil keeps the theme's disabled colour.
+	//
+	// Since: 2.7
+	DisabledColor color.Color
+
+	// TickLabels places a text label beside each tick mark, for compass points or
+	// clock faces. The slice must contain an entry per tick; shorter slices draw no
+	// labels at all rather than labelling only some ticks.
+	//
+	// Since: 2.7
+	TickLabels []string
+
+	// TickTapRadius is the distance from a tick mark within which a tap jumps straight
+	// to that tick's value, giving each tick a larger click target. Zero disables the
+	// snapping so taps always rotate towards the pointer.
+	//
+	// Since: 2.7
+	TickTapRadius float32
+
+	// DragThreshold is the distance the pointer must travel from the start of a drag
+	// before the value begins to track it, so a click without deliberate movement does
+	// not nudge the value through sub-pixel angle differences. Zero tracks immediately.
+	//
+	// Since: 2.7
+	DragThreshold float32
+
+	// AutoRTL mirrors the angular mapping when the system locale is written right to
+	// left, so the value grows towards the left as those locales expect. Left-to-right
+	// locales are unaffected.
+	//
+	// Since: 2.7
+	AutoRTL bool
+
+	// MinDiameter raises the minimum size of the knob beyond the theme default, for
+	// larger touch targets such as the 44px minimum that accessibility guidelines
+	// recommend. Values at or below the theme default, including negatives, are ignored.
+	//
+	// Since: 2.7
+	MinDiameter float32
+
+	// CompactThreshold is the knob diameter below which ticks, step labels and the
+	// centre dot are hidden, leaving only the track, active arc and indicator so tiny
+	// knobs stay legible. Zero uses a default of 40; a negative value never compacts.
+	//
+	// Since: 2.7
+	CompactThreshold float32
+
+	// TapBandOnly restricts taps to a radial band around the surrounding arc, so taps
+	// in the middle of the knob or outside the ring are ignored rather than jumping
+	// the value towards the pointer.
+	//
+	// Since: 2.7
+	TapBandOnly bool
+	// TapBandWidth is the half-width of the tap band either side of the arc, used when
+	// TapBandOnly is set. Zero falls back to the thickness of the arc itself.
+	//
+	// Since: 2.7
+	TapBandWidth float32
+
+	// HideIndicatorAtMin hides the indicator while the value sits exactly at Min,
+	// for designs where the minimum represents an "off" position.
+	//
+	// Since: 2.7
+	HideIndicatorAtMin bool
+	// HideIndicatorAtMax hides the indicator while the value sits exactly at Max.
+	//
+	// Since: 2.7
+	HideIndicatorAtMax bool
+
+	// Inverted draws the active arc as the remaining portion of the range, anchored at
+	// EndAngle, so a full arc depletes as the value increases - a battery or fuel style
+	// depletion gauge.
+	//
+	// Since: 2.7
+	Inverted bool
+
+	// Endless removes the Min/Max bounds so the value accumulates freely, like an
+	// endless rotary encoder; the indicator shows the position modulo the sweep while
+	// OnChanged reports the running total. Min and Max still size one full revolution.
+	//
+	// Since: 2.7
+	Endless bool
+
+	// IntegerOnly rounds every value to the nearest whole number, whatever Step is
+	// set to, so displayed and emitted values never carry fractions while dragging.
+	// Values that would round outside the range round inwards instead.
+	//
+	// Since: 2.7
+	IntegerOnly bool
+
+	// Wrapping wraps values that pass Max around to Min and vice versa, instead of
+	// clamping at the ends of the range. Unlike Endless the reported value always
+	// stays within Min and Max. All input paths honour this, including Scrolled.
+	//
+	// Since: 2.7
+	Wrapping bool
+
+	// DisableAnimations suppresses every animated transition on this knob - the hover
+	// glow fade and the spring return - applying changes instantly instead. Animations
+	// are also suppressed when the application settings turn them off, so
+	// vestibular-sensitive users who prefer reduced motion are honoured either way.
+	//
+	// Since: 2.7
+	DisableAnimations bool
+
+	// HoverAnimation animates the hover glow in and out instead of jumping between
+	// states. Constructors enable it by default; clear it for performance or for
+	// reduced-motion preferences.
+	//
+	// Since: 2.7
+	HoverAnimation bool
+
+	// SpringReturn makes the knob momentary: when a drag ends or the pointer leaves,
+	// the value animates back to SpringTarget, like a spring-return throttle.
+	// OnChanged fires during the return and OnChangeEnded once it comes to rest.
+	//
+	// Since: 2.7
+	SpringReturn bool
+	// SpringTarget is the value a SpringReturn knob returns to. The zero value
+	// returns the knob to 0, so set it explicitly for other rest positions.
+	//
+	// Since: 2.7
+	SpringTarget float64
+
+	// AllowEllipse stretches the pointer maths to fill non-square bounds, using
+	// separate horizontal and vertical radii so drags around the inscribed ellipse
+	// still feel angular instead of being squeezed by the narrower dimension. The
+	// arc itself always draws as a circle within the smaller dimension.
+	//
+	// Since: 2.7
+	AllowEllipse bool
+
+	// ScrollStepMultiplier scales Step for each mouse wheel notch, so large ranges can
+	// move faster and small ranges slower. Values of zero or below are treated as 1.
+	//
+	// Since: 2.7
+	ScrollStepMultiplier float64
+	// InvertScroll flips the scroll direction so scrolling up decreases the value,
+	// matching platforms configured for "natural" scrolling.
+	//
+	// Since: 2.7
+	InvertScroll bool
+
+	// ScrollAcceleration increases the effective scroll step when wheel events arrive
+	// in rapid succession, resetting after an idle gap.
+	//
+	// Since: 2.7
+	ScrollAcceleration bool
+
+	// ValueFormatter renders a value as display text, for readouts and the
+	// OnValueFormatted callback. Percentages, units and enumerated names all fit.
+	//
+	// Since: 2.7
+	ValueFormatter func(float64) string `json:"-"`
+
+	// ValueColorFunc, when set, picks the colour of the active arc, indicator and
+	// centre dot from the current value on every refresh - for example shifting from
+	// blue to red as a temperature rises. Nil values fall back to the theme
+	// foreground, and the disabled palette always wins.
+	//
+	// Since: 2.7
+	ValueColorFunc func(float64) color.Color `json:"-"`
+
+	// MinChangeDelta suppresses OnChanged until the value has moved at least this
+	// far from the last notified value, for callers whose handlers are too costly
+	// to run on every small movement. The knob still updates and redraws
+	// continuously, and OnChangeEnded always reports the final value at gesture
+	// end. Zero notifies every change as before.
+	//
+	// Since: 2.7
+	MinChangeDelta float64
+
+	OnChanged     func(float64) `json:"-"`
+	OnChangeEnded func(float64) `json:"-"`
+
+	// OnChangeEndedSource fires alongside OnChangeEnded, additionally reporting which
+	// input path produced the change being committed, so applications can measure how
+	// users interact with their knobs. The plain OnChangeEnded remains for callers
+	// that do not care about the source.
+	//
+	// Since: 2.7
+	OnChangeEndedSource func(value float64, source ChangeSource) `json:"-"`
+
+	// OnReset fires only when the value is reset to DefaultValue, whether by
+	// double-tap or a call to Reset, so applications can log reverts separately
+	// from ordinary changes reported through OnChanged.
+	//
+	// Since: 2.7
+	OnReset func() `json:"-"`
+
+	// DefaultValue is the value that a reset restores, clamped to the range like
+	// any other value. Set it alongside Min and Max for knobs with a natural rest
+	// position, such as zero gain.
+	//
+	// Since: 2.7
+	DefaultValue float64
+
+	// AbsoluteDrag, when set, snaps the value straight to the pointer's angle as a
+	// drag begins, as this knob has always done. Constructors turn it on; clear it
+	// for relative dragging, where the grab point keeps its angular offset from the
+	// indicator and deltas are applied instead, so grabbing off-thumb does not jump
+	// the value - the behaviour hardware-knob users expect.
+	//
+	// Since: 2.7
+	AbsoluteDrag bool
+
+	// ResetShortcut names a key that, pressed while the knob is focused, resets it
+	// to DefaultValue exactly as a double-tap does. The default empty name disables
+	// the shortcut. Letter keys are safe choices as the knob does not consume runes
+	// for type-in, but avoid the arrow keys and Escape, which step and cancel drags.
+	//
+	// Since: 2.7
+	ResetShortcut fyne.KeyName
+
+	// HybridDrag, when set, chooses each drag gesture's behaviour by where it
+	// starts: in the outer annulus the pointer's angle drives the value as usual,
+	// while inside HybridThreshold of the radius vertical movement makes fine
+	// relative adjustments instead. Quick sweeps on the ring and precise tweaks in
+	// the centre then coexist in one control.
+	//
+	// Since: 2.7
+	HybridDrag bool
+
+	// HybridThreshold is the fraction of the knob radius inside which a HybridDrag
+	// gesture becomes a fine adjustment. Zero or less means half the radius.
+	//
+	// Since: 2.7
+	HybridThreshold float32
+
+	// TouchOffsetY shifts the effective drag point this many pixels above the
+	// finger on mobile devices, so the value tracks just clear of the touch instead
+	// of under it. Desktop pointers are unaffected.
+	//
+	// Since: 2.7
+	TouchOffsetY float32
+
+	// TrackThicknessRatio sets the surrounding track's stroke as a fraction of the
+	// knob radius. When zero the stroke instead scales with the diameter, clamped
+	// between 4 and 16 pixels, so the ring stays in proportion at any size.
+	//
+	// Since: 2.7
+	TrackThicknessRatio float32
+
+	// HighContrast switches this knob to a low-vision presentation: thicker
+	// strokes, the theme.AccessiblePalette colors in place of subtler tones and a
+	// wider focus glow. It is a per-widget override that complements any
+	// high-contrast application theme.
+	//
+	// Since: 2.7
+	HighContrast bool
+	// OnValueFormatted fires alongside OnChanged when ValueFormatter is set, passing
+	// both the raw value and its formatted text so the formatter runs exactly once
+	// per change and label text cannot drift from the value it describes.
+	//
+	// Since: 2.7
+	OnValueFormatted func(value float64, text string) `json:"-"`
+	// OnDelta fires with the signed change applied by each drag, scroll or key step,
+	// for consumers such as jog wheels that care about movement rather than the
+	// absolute value.
+	//
+	// Since: 2.7
+	OnDelta func(delta float64) `json:"-"`
+
+	// OnCenterTapped fires instead of the usual value jump when a tap lands on the
+	// centre dot, so the dot can act as a button - toggling mute on a volume knob,
+	// for example. When nil, centre taps rotate the knob towards the pointer as usual.
+	//
+	// Since: 2.7
+	OnCenterTapped func() `json:"-"`
+
+	// OnTappedSecondary fires when the knob is right- or long-tapped, for showing a
+	// context menu or options popover. Disabled knobs ignore secondary taps like
+	// every other interaction.
+	//
+	// Since: 2.7
+	OnTappedSecondary func(*fyne.PointEvent) `json:"-"`
+
+	// CenterIcon, when set, draws the given resource over the centre dot, scaled to
+	// the dot's size - a power symbol on an amplifier gain knob, for example. Taps
+	// on the icon area still reach OnCenterTapped. When nil the plain dot shows as
+	// before.
+	//
+	// Since: 2.7
+	CenterIcon fyne.Resource
+
+	// OnBoundary fires once when the value first reaches Min or Max during a gesture,
+	// re-arming when the value leaves the boundary again. Apps can flash the arc or
+	// play a sound as feedback that the end of the range was hit.
+	//
+	// Since: 2.7
+	OnBoundary func(atMax bool) `json:"-"`
+
+	// StatusBinding, when set, receives a human-readable status message such as
+	// "Volume set to 5" each time a change ends. A single bound label can then
+	// announce changes from multiple controls for screen-reader-friendly layouts.
+	//
+	// Since: 2.7
+	StatusBinding binding.String `json:"-"`
+	// StatusName is the control name used in StatusBinding messages, "Value" if empty.
+	//
+	// Since: 2.7
+	StatusName string
+	// MinReachedText is the StatusBinding message announced when the value first
+	// reaches Min, "Minimum reached" if empty.
+	//
+	// Since: 2.7
+	MinReachedText string
+	// MaxReachedText is the StatusBinding message announced when the value first
+	// reaches Max, "Maximum reached" if empty.
+	//
+	// Since: 2.7
+	MaxReachedText string
+
+	value         float64
+	disabled      bool
+	focused       bool
+	hovered       bool
+	isDragging    bool          // true while a drag gesture is in progress
+	dragOrigin    fyne.Position // pointer position where the current drag began
+	dragStart     float64       // value when the current drag began, restored if it is cancelled
+	dragOffset    float64       // value offset of the grab point from the indicator, for relative drags
+	dragTracking  bool          // true once the drag has travelled past DragThreshold
+	touchTime     time.Time     // when the current touch began, for long-press detection
+	fineMode      bool          // true while a long-press drag is making fine adjustments
+	hybridFine    bool          // true while a HybridDrag gesture that began in the centre is in progress
+	shiftDown     bool          // true while a shift key is held, for fine keyboard stepping
+	lastModality  InputModality // the input kind seen most recently, gating the focus ring
+	springAnim    *fyne.Animation
+	hoverAnim     *fyne.Animation
+	pulseAnim     *fyne.Animation
+	glowFrac      float32      // how far the hover glow has faded in, 0 through 1
+	atBoundary    bool         // true once OnBoundary fired, until the value leaves the boundary
+	pendingChange bool         // true if value changed since last OnChangeEnded
+	lastNotified  float64      // value OnChanged last reported, for MinChangeDelta gating
+	lastChange    ChangeSource // the input path behind the most recent value change
+
+	lastScroll   time.Time
+	scrollFactor float64
+
+	updating       bool // true between BeginUpdate and EndUpdate
+	refreshPending bool // true if a refresh was suppressed while updating
+
+	linked  []*RotatingKnob
+	syncing bool // true while applying a value that arrived from a linked knob
+
+	changeLogger func(time.Time, float64, ChangeSource)
+	changeSource ChangeSource // input path driving the mutation currently in progress
+
+	binder          basicBinder
+	bindingUpdating bool // true while applying a value that arrived from the bound data
+	disabledBinder  basicBinder
+}
+
+// KnobRounding selects how a knob value is rounded to the nearest Step multiple.
+//
+// Since: 2.7
+type KnobRounding int
+
+// Rounding modes for snapping knob values onto Step multiples.
+//
+// Since: 2.7
+const (
+	// RoundHalfDown rounds values halfway between two steps towards Min.
+	RoundHalfDown KnobRounding = iota
+	// RoundHalfUp rounds values halfway between two steps towards Max.
+	RoundHalfUp
+	// RoundHalfEven rounds values halfway between two steps to the even step
+	// multiple, the banker's rounding that avoids drift in accumulated changes.
+	RoundHalfEven
+)
+
+// ChangeSource identifies the input path that produced a knob value change, as
+// reported to a change logger installed with SetChangeLogger.
+//
+// Since: 2.7
+type ChangeSource int
+
+// Input paths that can change a knob value.
+//
+// Since: 2.7
+const (
+	// ChangeSourceSet is a programmatic change through SetValue.
+	ChangeSourceSet ChangeSource = iota
+	// ChangeSourceDrag is a pointer drag around the knob.
+	ChangeSourceDrag
+	// ChangeSourceTap is a tap jumping the value towards the pointer.
+	ChangeSourceTap
+	// ChangeSourceKey is a keyboard arrow step.
+	ChangeSourceKey
+	// ChangeSourceScroll is a mouse wheel or trackpad scroll step.
+	ChangeSourceScroll
+	// ChangeSourceBinding is a change that arrived through a bound data item.
+	ChangeSourceBinding
+)
+
+// String returns a short name for the change source, for logs and telemetry.
+func (s ChangeSource) String() string {
+	switch s {
+	case ChangeSourceDrag:
+		return "drag"
+	case ChangeSourceTap:
+		return "tap"
+	case ChangeSourceKey:
+		return "key"
+	case ChangeSourceScroll:
+		return "scroll"
+	case ChangeSourceBinding:
+		return "binding"
+	}
+	return "set"
+}
+
+// NewRotatingKnob returns a knob that adjusts a value between the given minimum and maximum.
+//
+// Since: 2.7
+func NewRotatingKnob(min, max float64) *RotatingKnob {
+	knob := &RotatingKnob{
+		Min:        min,
+		Max:        max,
+		Step:       1,
+		StartAngle: -135,
+		EndAngle:   135,
+		TickCount:  defaultKnobTickCount,
+
+		AbsoluteDrag:   true,
+		HoverAnimation: true,
+	}
+	knob.value = min
+	knob.ExtendBaseWidget(knob)
+	return knob
+}
+
+// NewRotatingKnobWithGap returns a knob whose sweep is defined by the angular gap
+// left at the bottom of the dial, in degrees, rather than by explicit start and end
+// angles. The sweep is placed symmetrically around the top, so a gap of 90 is
+// equivalent to the default -135 to 135 dial. The gap is clamped to 0 through 360.
+//
+// Since: 2.7
+func NewRotatingKnobWithGap(min, max, gapDegrees float64) *RotatingKnob {
+	gapDegrees = math.Min(math.Max(gapDegrees, 0), 360)
+	knob := NewRotatingKnob(min, max)
+	half := float32(360-gapDegrees) / 2
+	knob.StartAngle = -half
+	knob.EndAngle = half
+	return knob
+}
+
+// NewRotatingKnobWithData returns a knob connected with the specified data source.
+//
+// Since: 2.7
+func NewRotatingKnobWithData(min, max float64, data binding.Float) *RotatingKnob {
+	knob := NewRotatingKnob(min, max)
+	knob.Bind(data)
+
+	return knob
+}
+
+// Bind connects the specified data source to this knob.
+// The current value will be displayed and any changes in the data will cause the widget to update.
+// User interactions with this knob will set the value into the data source.
+//
+// Since: 2.7
+func (k *RotatingKnob) Bind(data binding.Float) {
+	k.binder.SetCallback(k.updateFromData)
+	k.binder.Bind(data)
+
+	k.OnChanged = func(_ float64) {
+		if k.bindingUpdating { // the change arrived from the data, don't echo it back
+			return
+		}
+		k.binder.CallWithData(k.writeData)
+	}
+}
+
+// Unbind disconnects any configured data source from this knob.
+// The current value will remain at the last value of the data source.
+//
+// Since: 2.7
+func (k *RotatingKnob) Unbind() {
+	k.OnChanged = nil
+	k.binder.Unbind()
+}
+
+// BindDisabled connects the given boolean source to this knob's disabled state, so
+// app state can lock and unlock knobs declaratively. While the bound value is true
+// the knob is disabled, and it enables again when the value returns to false.
+//
+// Since: 2.7
+func (k *RotatingKnob) BindDisabled(data binding.Bool) {
+	k.disabledBinder.SetCallback(k.updateDisabledFromData)
+	k.disabledBinder.Bind(data)
+}
+
+// UnbindDisabled disconnects any disabled-state source from this knob. The knob
+// keeps whichever state the data last applied, as Unbind does for the value.
+//
+// Since: 2.7
+func (k *RotatingKnob) UnbindDisabled() {
+	k.disabledBinder.Unbind()
+}
+
+func (k *RotatingKnob) updateDisabledFromData(data binding.DataItem) {
+	if data == nil {
+		return
+	}
+	boolSource, ok := data.(binding.Bool)
+	if !ok {
+		return
+	}
+
+	disabled, err := boolSource.Get()
+	if err != nil {
+		fyne.LogError("Error getting current data value", err)
+		return
+	}
+
+	if disabled {
+		k.Disable()
+	} else {
+		k.Enable()
+	}
+}
+
+func (k *RotatingKnob) updateFromData(data binding.DataItem) {
+	if data == nil {
+		return
+	}
+	floatSource, ok := data.(binding.Float)
+	if !ok {
+		return
+	}
+
+	val, err := floatSource.Get()
+	if err != nil {
+		fyne.LogError("Error getting current data value", err)
+		return
+	}
+
+	k.bindingUpdating = true
+	k.changeSource = ChangeSourceBinding
+	k.SetValue(val)
+	k.changeSource = ChangeSourceSet
+	k.bindingUpdating = false
+}
+
+func (k *RotatingKnob) writeData(data binding.DataItem) {
+	if data == nil {
+		return
+	}
+	floatTarget, ok := data.(binding.Float)
+	if !ok {
+		return
+	}
+	currentValue, err := floatTarget.Get()
+	if err != nil {
+		return
+	}
+	if k.value != currentValue {
+		err := floatTarget.Set(k.value)
+		if err != nil {
+			fyne.LogError(fmt.Sprintf("Failed to set binding value to %f", k.value), err)
+		}
+	}
+}
+
+// Value returns the current value of the knob, clamped to the configured range.
+//
+// Since: 2.7
+func (k *RotatingKnob) Value() float64 {
+	return k.value
+}
+
+// SetValue updates the value of the knob and clamps it to be within the range.
+//
+// Since: 2.7
+func (k *RotatingKnob) SetValue(value float64) {
+	if k.value == value {
+		return
+	}
+
+	lastValue := k.value
+	k.value = value
+
+	k.clampValueToRange()
+	k.positionChanged(lastValue, k.value)
+	k.fireChangeEnded()
+}
+
+// EffectiveStep returns the increment that keyboard and scroll adjustments use:
+// Step when it is set, otherwise one percent of the range. Consumers implementing
+// their own shortcuts can query it so every input path moves by the same amount.
+//
+// Since: 2.7
+func (k *RotatingKnob) EffectiveStep() float64 {
+	if k.Step > 0 {
+		return k.Step
+	}
+	return (k.Max - k.Min) / 100
+}
+
+// Nudge moves the knob by the given number of effective steps, negative to
+// decrease, exactly as that many arrow key presses would - including clamping or
+// wrapping at the range ends and moving through adjacent Steps entries in
+// discrete mode. It gives macros and tests a clean programmatic way to move the
+// control without synthesizing input events.
+//
+// Since: 2.7
+func (k *RotatingKnob) Nudge(steps int) {
+	if !k.canInteract() || steps == 0 {
+		return
+	}
+	k.stopSpringReturn()
+
+	if len(k.Steps) > 0 {
+		up := steps > 0
+		if !up {
+			steps = -steps
+		}
+		for ; steps > 0; steps-- {
+			k.moveAdjacentStep(up)
+		}
+		return
+	}
+	k.SetValue(k.value + float64(steps)*k.EffectiveStep())
+}
+
+// Percent returns how far the current value sits between Min and Max as a
+// percentage, or 0 when the range is empty.
+//
+// Since: 2.7
+func (k *RotatingKnob) Percent() float64 {
+	if k.Max == k.Min {
+		return 0
+	}
+	return (k.value - k.Min) / (k.Max - k.Min) * 100
+}
+
+// SetPercent updates the value to sit the given percentage of the way between
+// Min and Max, clamping to the range like SetValue.
+//
+// Since: 2.7
+func (k *RotatingKnob) SetPercent(percent float64) {
+	k.SetValue(k.Min + (k.Max-k.Min)*percent/100)
+}
+
+// Reset restores the knob to DefaultValue, firing OnChanged through SetValue when
+// the value moves and then OnReset. When DefaultValue is unset and zero lies
+// outside the range, the knob returns to the midpoint of Min and Max instead, so
+// resetting never slams an un-configured knob against an end of its range.
+//
+// Since: 2.7
+func (k *RotatingKnob) Reset() {
+	target := k.DefaultValue
+	if target == 0 && (k.Min > 0 || k.Max < 0) {
+		target = (k.Min + k.Max) / 2
+	}
+	k.SetValue(target)
+	if k.OnReset != nil {
+		k.OnReset()
+	}
+}
+
+// DoubleTapped resets the knob to its DefaultValue.
+//
+// Since: 2.7
+func (k *RotatingKnob) DoubleTapped(_ *fyne.PointEvent) {
+	if !k.canInteract() {
+		return
+	}
+	k.Reset()
+}
+
+// TappedSecondary is called when the knob is right- or long-tapped, passing the
+// event through to OnTappedSecondary.
+//
+// Since: 2.7
+func (k *RotatingKnob) TappedSecondary(e *fyne.PointEvent) {
+	if !k.canInteract() {
+		return
+	}
+	if k.OnTappedSecondary != nil {
+		k.OnTappedSecondary(e)
+	}
+}
+
+// MarkupName identifies this widget in rendered test markup, keeping golden files
+// unambiguous however the implementation is shared.
+//
+// Since: 2.7
+func (k *RotatingKnob) MarkupName() string {
+	return "RotatingKnob"
+}
+
+// AccessibilityNode describes this knob for accessibility testing, reporting the
+// role "knob" together with StatusName and the current value.
+//
+// Since: 2.7
+func (k *RotatingKnob) AccessibilityNode() (role, name, value string) {
+	return "knob", k.StatusName, strconv.FormatFloat(k.value, 'f', -1, 64)
+}
+
+// LinkTo links this knob with another so that changing either value also moves the
+// other. A disabled knob cannot be interacted with, so it never drives its link
+// partner, but it still follows programmatic and interactive changes made to the
+// other knob.
+//
+// Since: 2.7
+func (k *RotatingKnob) LinkTo(other *RotatingKnob) {
+	if other == nil || other == k {
+		return
+	}
+	k.linked = append(k.linked, other)
+	other.linked = append(other.linked, k)
+}
+
+func (k *RotatingKnob) propagateToLinked() {
+	if k.syncing {
+		return
+	}
+	for _, other := range k.linked {
+		other.syncFromLink(k.value)
+	}
+}
+
+func (k *RotatingKnob) syncFromLink(value float64) {
+	if k.syncing {
+		return
+	}
+	k.syncing = true
+	k.SetValue(value)
+	k.syncing = false
+}
+
+// Clone returns a new knob with the same range, angles, ticks and other style fields
+// as this one, so rows of matching knobs need configuring only once. Callbacks, data
+// bindings, links and the current value are not copied; the clone starts at Min.
+//
+// Since: 2.7
+func (k *RotatingKnob) Clone() *RotatingKnob {
+	clone := NewRotatingKnob(k.Min, k.Max)
+	clone.Step = k.Step
+	clone.Rounding = k.Rounding
+	clone.StartAngle = k.StartAngle
+	clone.EndAngle = k.EndAngle
+	clone.TickCount = k.TickCount
+	clone.TickColor = k.TickColor
+	clone.WedgeColor = k.WedgeColor
+	clone.WedgeGradient = k.WedgeGradient
+	clone.ShowGap = k.ShowGap
+	clone.GapColor = k.GapColor
+	clone.DisabledColor = k.DisabledColor
+	clone.Steps = append([]float64(nil), k.Steps...)
+	clone.StepLabels = append([]string(nil), k.StepLabels...)
+	clone.TickLabels = append([]string(nil), k.TickLabels...)
+	clone.TickPositions = append([]float64(nil), k.TickPositions...)
+	if k.Notch != nil {
+		notch := *k.Notch
+		clone.Notch = &notch
+	}
+	clone.TickTapRadius = k.TickTapRadius
+	clone.DragThreshold = k.DragThreshold
+	clone.AutoRTL = k.AutoRTL
+	clone.MinDiameter = k.MinDiameter
+	clone.CompactThreshold = k.CompactThreshold
+	clone.TapBandOnly = k.TapBandOnly
+	clone.TapBandWidth = k.TapBandWidth
+	clone.HideIndicatorAtMin = k.HideIndicatorAtMin
+	clone.HideIndicatorAtMax = k.HideIndicatorAtMax
+	clone.Inverted = k.Inverted
+	clone.AllowEllipse = k.AllowEllipse
+	clone.HoverAnimation = k.HoverAnimation
+	clone.DisableAnimations = k.DisableAnimations
+	clone.ScrollStepMultiplier = k.ScrollStepMultiplier
+	clone.ScrollAcceleration = k.ScrollAcceleration
+	clone.InvertScroll = k.InvertScroll
+	clone.Endless = k.Endless
+	clone.IntegerOnly = k.IntegerOnly
+	clone.Wrapping = k.Wrapping
+	clone.SpringReturn = k.SpringReturn
+	clone.SpringTarget = k.SpringTarget
+	clone.MinChangeDelta = k.MinChangeDelta
+	clone.DefaultValue = k.DefaultValue
+	clone.AbsoluteDrag = k.AbsoluteDrag
+	clone.ResetShortcut = k.ResetShortcut
+	clone.HybridDrag = k.HybridDrag
+	clone.HybridThreshold = k.HybridThreshold
+	clone.TouchOffsetY = k.TouchOffsetY
+	clone.TrackThicknessRatio = k.TrackThicknessRatio
+	clone.HighContrast = k.HighContrast
+	clone.CenterIcon = k.CenterIcon
+	clone.StatusName = k.StatusName
+	clone.MinReachedText = k.MinReachedText
+	clone.MaxReachedText = k.MaxReachedText
+	return clone
+}
+
+// SetChangeLogger installs a callback that receives every value change with a
+// timestamp and the input path that produced it, for telemetry and debugging.
+// Pass nil to remove a previously installed logger.
+//
+// Since: 2.7
+func (k *RotatingKnob) SetChangeLogger(logger func(t time.Time, value float64, source ChangeSource)) {
+	k.changeLogger = logger
+}
+
+// BeginUpdate suspends refreshes of this knob so that many fields can be configured as
+// a batch, avoiding redundant layout. Call EndUpdate to apply the changes in one refresh.
+//
+// Since: 2.7
+func (k *RotatingKnob) BeginUpdate() {
+	k.updating = true
+}
+
+// EndUpdate resumes refreshes after BeginUpdate, refreshing once if anything changed
+// while updates were suspended.
+//
+// Since: 2.7
+func (k *RotatingKnob) EndUpdate() {
+	if !k.updating {
+		return
+	}
+	k.updating = false
+	if k.refreshPending {
+		k.refreshPending = false
+		k.Refresh()
+	}
+}
+
+// Refresh causes this knob to be redrawn in its current state, unless updates are
+// currently suspended by BeginUpdate.
+func (k *RotatingKnob) Refresh() {
+	if k.updating {
+		k.refreshPending = true
+		return
+	}
+	k.BaseWidget.Refresh()
+}
+
+// TouchDown is called when this knob is pressed on a mobile device, recording the
+// press time so a long press can switch the following drag into fine-adjust mode.
+//
+// Since: 2.7
+func (k *RotatingKnob) TouchDown(_ *mobile.TouchEvent) {
+	if !k.canInteract() {
+		return
+	}
+	k.stopPulse()
+	k.touchTime = time.Now()
+}
+
+// TouchUp is called when a mobile touch on this knob is lifted.
+//
+// Since: 2.7
+func (k *RotatingKnob) TouchUp(_ *mobile.TouchEvent) {
+	k.touchTime = time.Time{}
+}
+
+// TouchCancel is called when a mobile touch on this knob is cancelled.
+//
+// Since: 2.7
+func (k *RotatingKnob) TouchCancel(_ *mobile.TouchEvent) {
+	k.touchTime = time.Time{}
+}
+
+// IsDragging reports whether a pointer drag is currently adjusting this knob,
+// from the first Dragged event until DragEnd. Surrounding UI can use this to
+// avoid acting on intermediate values.
+//
+// Since: 2.7
+func (k *RotatingKnob) IsDragging() bool {
+	return k.isDragging
+}
+
+// DragEnd is called when the drag ends.
+func (k *RotatingKnob) DragEnd() {
+	k.isDragging = false
+	k.dragTracking = false
+	k.hybridFine = false
+	if k.fineMode {
+		k.fineMode = false
+		k.Refresh()
+	}
+	if !k.disabled {
+		k.fireChangeEnded()
+		k.startSpringReturn()
+	}
+}
+
+// startSpringReturn begins animating the value back to SpringTarget, replacing any
+// return already under way.
+func (k *RotatingKnob) startSpringReturn() {
+	if !k.SpringReturn || k.value == k.SpringTarget {
+		return
+	}
+
+	k.stopSpringReturn()
+	start := k.value
+	if !k.animationsEnabled() {
+		k.springFrame(start, 1) // reduced motion, jump straight to rest
+		return
+	}
+	anim := fyne.NewAnimation(canvas.DurationStandard, func(f float32) {
+		k.springFrame(start, f)
+	})
+	anim.Curve = fyne.AnimationEaseOut
+	k.springAnim = anim
+	anim.Start()
+}
+
+// springFrame applies one frame of the spring return, interpolating from the value
+// the gesture released at towards SpringTarget.
+func (k *RotatingKnob) springFrame(start float64, done float32) {
+	lastValue := k.value
+	k.value = start + (k.SpringTarget-start)*float64(done)
+	k.positionChanged(lastValue, k.value)
+	if done >= 1 {
+		k.springAnim = nil
+		k.fireChangeEnded()
+	}
+}
+
+// animationsEnabled reports whether this knob should animate transitions, honouring
+// both DisableAnimations and the application's animation setting.
+func (k *RotatingKnob) animationsEnabled() bool {
+	return !k.DisableAnimations && fyne.CurrentApp().Settings().ShowAnimations()
+}
+
+// stopSpringReturn cancels a running spring return, leaving the value wherever the
+// animation had reached.
+func (k *RotatingKnob) stopSpringReturn() {
+	if k.springAnim == nil {
+		return
+	}
+	k.springAnim.Stop()
+	k.springAnim = nil
+}
+
+// Dragged is called when a drag event occurs.
+func (k *RotatingKnob) Dragged(e *fyne.DragEvent) {
+	if !k.canInteract() {
+		return
+	}
+	if k.TouchOffsetY != 0 && knobOnMobile() {
+		shifted := *e // track above the finger rather than under it
+		shifted.Position.Y -= k.TouchOffsetY
+		e = &shifted
+	}
+	k.stopPulse()
+	k.stopSpringReturn()
+	if !k.isDragging {
+		k.isDragging = true
+		k.dragStart = k.value
+		k.dragOrigin = e.Position.SubtractXY(e.Dragged.DX, e.Dragged.DY)
+		k.dragOffset = k.value - k.valueForPoint(&fyne.PointEvent{Position: k.dragOrigin})
+		k.hybridFine = k.HybridDrag && k.inHybridCenter(k.dragOrigin)
+		k.dragTracking = k.DragThreshold <= 0
+		if knobOnMobile() && !k.touchTime.IsZero() && timeNow().Sub(k.touchTime) >= knobFinePressDelay {
+			k.fineMode = true // held long enough, make this gesture a fine adjustment
+			k.Refresh()
+		}
+	}
+	if !k.dragTracking {
+		dx := float64(e.Position.X - k.dragOrigin.X)
+		dy := float64(e.Position.Y - k.dragOrigin.Y)
+		if math.Hypot(dx, dy) <= float64(k.DragThreshold) {
+			return
+		}
+		k.dragTracking = true
+	}
+	k.changeSource = ChangeSourceDrag
+	defer func() { k.changeSource = ChangeSourceSet }()
+	lastValue := k.value
+
+	if k.hybridFine {
+		// a hybrid gesture that began in the centre: vertical movement nudges the
+		// value, up to increase, geared so a full sweep takes knobHybridFineRange px
+		k.value += float64(-e.Dragged.DY) * (k.Max - k.Min) / knobHybridFineRange
+	} else if k.Endless {
+		delta := k.endlessDelta(k.valueForPoint(&e.PointEvent))
+		if k.fineMode {
+			delta *= knobFineModeScale
+		}
+		k.value += delta
+	} else {
+		target := k.valueForPoint(&e.PointEvent)
+		if !k.AbsoluteDrag {
+			target += k.dragOffset // keep the grab point's offset so nothing jumps
+		}
+		if k.fineMode {
+			k.value += (target - k.value) * knobFineModeScale
+		} else {
+			k.value = target
+		}
+	}
+	k.clampValueToRange()
+	k.positionChanged(lastValue, k.value)
+}
+
+// endlessDelta returns the signed change implied by dragging to the given in-range
+// position, taking the shortest way around so the total can accumulate across wraps.
+func (k *RotatingKnob) endlessDelta(position float64) float64 {
+	span := k.Max - k.Min
+	if span == 0 {
+		return 0
+	}
+
+	current := k.Min + math.Mod(k.value-k.Min, span)
+	if current < k.Min {
+		current += span
+	}
+
+	delta := position - current
+	if delta > span/2 {
+		delta -= span
+	} else if delta < -span/2 {
+		delta += span
+	}
+	return delta
+}
+
+// scrollAccelerationGap is the longest pause between wheel events that still builds acceleration.
+const scrollAccelerationGap = 200 * time.Millisecond
+
+// scrollAccelerationLimit caps how much the effective step can grow through acceleration.
+const scrollAccelerationLimit = 8.0
+
+// Scrolled is called when the mouse wheel moves over the knob, adjusting the value by
+// one step per notch scaled by ScrollStepMultiplier and any built up acceleration.
+//
+// Since: 2.7
+func (k *RotatingKnob) Scrolled(e *fyne.ScrollEvent) {
+	if !k.canInteract() {
+		return
+	}
+	k.stopPulse()
+	k.changeSource = ChangeSourceScroll
+	defer func() { k.changeSource = ChangeSourceSet }()
+	k.stopSpringReturn()
+
+	step := k.EffectiveStep()
+	if k.ScrollStepMultiplier > 0 {
+		step *= k.ScrollStepMultiplier
+	}
+	if k.ScrollAcceleration {
+		now := time.Now()
+		if k.scrollFactor < 1 || now.Sub(k.lastScroll) > scrollAccelerationGap {
+			k.scrollFactor = 1
+		} else if k.scrollFactor < scrollAccelerationLimit {
+			k.scrollFactor *= 2
+		}
+		k.lastScroll = now
+		step *= k.scrollFactor
+	}
+
+	up := e.Scrolled.DY > 0 || (e.Scrolled.DY == 0 && e.Scrolled.DX > 0)
+	if k.InvertScroll {
+		up = !up
+	}
+	if len(k.Steps) > 0 {
+		k.moveAdjacentStep(up)
+		return
+	}
+	if up {
+		k.SetValue(k.value + step)
+	} else {
+		k.SetValue(k.value - step)
+	}
+}
+
+// moveAdjacentStep moves the value to the next or previous Steps entry.
+func (k *RotatingKnob) moveAdjacentStep(up bool) {
+	current := k.nearestStep(k.value)
+	if up && current < len(k.Steps)-1 {
+		k.SetValue(k.Steps[current+1])
+	} else if !up && current > 0 {
+		k.SetValue(k.Steps[current-1])
+	}
+}
+
+// RequestFocus asks the given canvas to move keyboard focus to this knob and
+// refreshes so the focus decoration appears, saving callers from reaching into the
+// canvas focus API for "focus the first control" flows. A nil canvas is ignored.
+//
+// Since: 2.7
+func (k *RotatingKnob) RequestFocus(c fyne.Canvas) {
+	if c == nil || k.disabled {
+		return
+	}
+	if focusable, ok := k.super().(fyne.Focusable); ok {
+		c.Focus(focusable)
+	}
+	k.Refresh()
+}
+
+// FocusGained is called when this knob gained the focus. The focus ring only
+// draws when the focus arrived from the keyboard, per FocusRingVisible.
+//
+// Since: 2.7
+func (k *RotatingKnob) FocusGained() {
+	if k.disabled { // decline focus so Tab skips disabled knobs
+		return
+	}
+	k.focused = true
+	k.Refresh()
+}
+
+// FocusLost is called when this knob lost the focus. Any change still in progress is
+// treated as ended, so OnChangeEnded cannot be skipped by the window losing focus
+// mid-gesture.
+//
+// Since: 2.7
+func (k *RotatingKnob) FocusLost() {
+	k.focused = false
+	k.isDragging = false
+	k.fireChangeEnded()
+	if !k.disabled {
+		k.Refresh()
+	}
+}
+
+// MouseIn is called when a desktop pointer enters the knob.
+//
+// Since: 2.7
+func (k *RotatingKnob) MouseIn(_ *desktop.MouseEvent) {
+	k.hovered = true
+	k.animateGlow(1)
+}
+
+// MouseDown is called when a desktop pointer presses this knob. Taking focus here
+// keeps keyboard handling live during the drag that may follow, so Escape can
+// cancel it.
+//
+// Since: 2.7
+func (k *RotatingKnob) MouseDown(_ *desktop.MouseEvent) {
+	if !k.canInteract() {
+		return
+	}
+	k.lastModality = ModalityPointer // focus placed by the pointer needs no ring
+	k.RequestFocus(fyne.CurrentApp().Driver().CanvasForObject(k.super()))
+}
+
+// MouseUp is called when a desktop pointer is released over this knob.
+//
+// Since: 2.7
+func (k *RotatingKnob) MouseUp(_ *desktop.MouseEvent) {
+}
+
+// MouseMoved is called when a desktop pointer hovers over the knob.
+//
+// Since: 2.7
+func (k *RotatingKnob) MouseMoved(_ *desktop.MouseEvent) {
+}
+
+// MouseOut is called when a desktop pointer exits the knob. An active drag is left
+// running - the driver keeps routing drag events to this knob until DragEnd, so
+// fast gestures that overshoot the bounds keep tracking instead of stalling.
+//
+// Since: 2.7
+func (k *RotatingKnob) MouseOut() {
+	k.hovered = false
+	k.animateGlow(0)
+}
+
+// animateGlow transitions the hover glow towards the given fraction, jumping
+// straight there when hover animation is turned off.
+func (k *RotatingKnob) animateGlow(target float32) {
+	if k.hoverAnim != nil {
+		k.hoverAnim.Stop()
+		k.hoverAnim = nil
+	}
+	if !k.HoverAnimation || !k.animationsEnabled() {
+		k.glowFrac = target
+		k.Refresh()
+		return
+	}
+
+	from := k.glowFrac
+	anim := fyne.NewAnimation(canvas.DurationShort, func(f float32) {
+		k.glowFrac = from + (target-from)*f
+		if f >= 1 {
+			k.hoverAnim = nil
+		}
+		k.Refresh()
+	})
+	k.hoverAnim = anim
+	anim.Start()
+}
+
+// Pulse swells the knob's glow the given number of times to draw the eye during
+// onboarding, then returns it to normal. Any interaction with the knob cancels
+// the remaining pulses, as does having animations disabled, so the highlight
+// never fights the user for the control.
+//
+// Since: 2.7
+func (k *RotatingKnob) Pulse(times int) {
+	k.stopPulse()
+	if times <= 0 || !k.animationsEnabled() {
+		return
+	}
+
+	completed := 0
+	anim := fyne.NewAnimation(canvas.DurationStandard, func(f float32) {
+		k.glowFrac = float32(math.Sin(float64(f) * math.Pi)) // swell, then relax
+		if f >= 1 {
+			k.glowFrac = 0 // avoid a rounding remnant keeping the glow lit
+			if completed++; completed >= times {
+				k.pulseAnim = nil
+			}
+		}
+		k.Refresh()
+	})
+	anim.RepeatCount = times - 1
+	k.pulseAnim = anim
+	anim.Start()
+}
+
+// stopPulse cancels a running onboarding pulse, clearing the glow it was drawing.
+func (k *RotatingKnob) stopPulse() {
+	if k.pulseAnim == nil {
+		return
+	}
+	k.pulseAnim.Stop()
+	k.pulseAnim = nil
+	k.glowFrac = 0
+	k.Refresh()
+}
+
+// TypedKey is called when this knob receives a key event, moving one step (or one
+// Steps entry in discrete mode) for each arrow key press.
+//
+// Since: 2.7
+func (k *RotatingKnob) TypedKey(key *fyne.KeyEvent) {
+	if !k.canInteract() {
+		return
+	}
+	k.stopPulse()
+	k.lastModality = ModalityKeyboard
+	k.changeSource = ChangeSourceKey
+	defer func() { k.changeSource = ChangeSourceSet }()
+	k.stopSpringReturn()
+
+	step := k.EffectiveStep()
+	if k.shiftDown && step != 0 {
+		// drop Step to the fine increment so SetValue snaps at the finer resolution
+		step *= knobFineModeScale
+		coarse := k.Step
+		k.Step = step
+		defer func() { k.Step = coarse }()
+	}
+	switch key.Name {
+	case fyne.KeyUp, fyne.KeyRight:
+		if len(k.Steps) > 0 {
+			k.moveAdjacentStep(true)
+		} else {
+			k.SetValue(k.value + step)
+		}
+	case fyne.KeyDown, fyne.KeyLeft:
+		if len(k.Steps) > 0 {
+			k.moveAdjacentStep(false)
+		} else {
+			k.SetValue(k.value - step)
+		}
+	case fyne.KeyEscape:
+		if k.isDragging { // cancel the drag, reverting to where it began
+			k.isDragging = false
+			k.dragTracking = false
+			k.hybridFine = false
+			k.SetValue(k.dragStart)
+		}
+	default:
+		if k.ResetShortcut != "" && key.Name == k.ResetShortcut {
+			k.Reset()
+		}
+	}
+}
+
+// ShortcutHelp returns the knob's keyboard bindings in the form NewShortcutHelp
+// renders, including the ResetShortcut when one is configured, so applications
+// can show a consistent help overlay for the control.
+//
+// Since: 2.7
+func (k *RotatingKnob) ShortcutHelp() map[string]string {
+	help := map[string]string{
+		"Up / Right":  "Increase by one step",
+		"Down / Left": "Decrease by one step",
+		"Shift":       "Hold for fine steps",
+		"Escape":      "Cancel the drag in progress",
+	}
+	if k.ResetShortcut != "" {
+		help[string(k.ResetShortcut)] = "Reset to the default value"
+	}
+	return help
+}
+
+// TypedShortcut handles platform shortcuts while the knob is focused: copy puts
+// the current value on the clipboard, formatted through ValueFormatter when set,
+// and paste parses the clipboard back into a value. Clipboard content that does
+// not parse as a number is silently ignored.
+//
+// Since: 2.7
+func (k *RotatingKnob) TypedShortcut(shortcut fyne.Shortcut) {
+	switch sc := shortcut.(type) {
+	case *fyne.ShortcutCopy:
+		text := strconv.FormatFloat(k.value, 'f', -1, 64)
+		if k.ValueFormatter != nil {
+			text = k.ValueFormatter(k.value)
+		}
+		sc.Clipboard.SetContent(text)
+	case *fyne.ShortcutPaste:
+		if !k.canInteract() {
+			return
+		}
+		value, err := strconv.ParseFloat(strings.TrimSpace(sc.Clipboard.Content()), 64)
+		if err != nil {
+			return // not a number, nothing sensible to apply
+		}
+		k.SetValue(value)
+	}
+}
+
+// KeyDown is called when a key is pressed while this knob is focused, tracking the
+// shift modifier so arrow keys can make fine adjustments.
+//
+// Since: 2.7
+func (k *RotatingKnob) KeyDown(key *fyne.KeyEvent) {
+	if key.Name == desktop.KeyShiftLeft || key.Name == desktop.KeyShiftRight {
+		k.shiftDown = true
+	}
+}
+
+// KeyUp is called when a key is released while this knob is focused, resetting the
+// shift modifier state.
+//
+// Since: 2.7
+func (k *RotatingKnob) KeyUp(key *fyne.KeyEvent) {
+	if key.Name == desktop.KeyShiftLeft || key.Name == desktop.KeyShiftRight {
+		k.shiftDown = false
+	}
+}
+
+// TypedRune is called when this knob receives a char event.
+//
+// Since: 2.7
+func (k *RotatingKnob) TypedRune(_ rune) {
+}
+
+// Tapped is called when a pointer tapped event is captured, rotating the knob to point
+// at the tapped location.
+func (k *RotatingKnob) Tapped(e *fyne.PointEvent) {
+	if !k.canInteract() {
+		return
+	}
+	k.stopPulse()
+	k.lastModality = ModalityPointer
+	k.changeSource = ChangeSourceTap
+	defer func() { k.changeSource = ChangeSourceSet }()
+	k.stopSpringReturn()
+	if tick, ok := k.tickAtPoint(e.Position); ok {
+		k.SetValue(k.tickValue(tick))
+		return
+	}
+	if k.OnCenterTapped != nil && k.inCenterDot(e.Position) {
+		k.OnCenterTapped()
+		return
+	}
+	if k.TapBandOnly && !k.inTapBand(e.Position) {
+		return
+	}
+	lastValue := k.value
+
+	k.value = k.valueForPoint(e)
+	k.clampValueToRange()
+	k.positionChanged(lastValue, k.value)
+	k.fireChangeEnded()
+}
+
+// tickValue returns the value that the given tick index represents.
+func (k *RotatingKnob) tickValue(tick int) float64 {
+	if len(k.TickPositions) > 0 {
+		return k.TickPositions[tick]
+	}
+	if k.TickCount < 2 {
+		return k.Min
+	}
+	return k.Min + float64(tick)/float64(k.TickCount-1)*(k.Max-k.Min)
+}
+
+// tickCount returns how many tick marks the knob draws, explicit TickPositions
+// taking precedence over TickCount's uniform spacing.
+func (k *RotatingKnob) tickCount() int {
+	if len(k.TickPositions) > 0 {
+		return len(k.TickPositions)
+	}
+	return k.TickCount
+}
+
+// tickCenter returns the widget-relative position of the middle of the given tick mark.
+func (k *RotatingKnob) tickCenter(tick int) fyne.Position {
+	size := k.Size()
+	pad := k.Theme().Size(theme.SizeNameInnerPadding)
+	radius := (fyne.Min(size.Width, size.Height) - pad*2) / 2
+
+	angle := float64(k.angleForValue(k.tickValue(tick))) * math.Pi / 180
+	reach := float64(radius) * (1 - knobArcThickness)
+	return fyne.NewPos(
+		size.Width/2+float32(math.Sin(angle)*reach),
+		size.Height/2-float32(math.Cos(angle)*reach))
+}
+
+// inCenterDot reports whether the given position lies within the centre dot, the hit
+// region that OnCenterTapped responds to.
+func (k *RotatingKnob) inCenterDot(pos fyne.Position) bool {
+	size := k.Size()
+	dx := float64(pos.X - size.Width/2)
+	dy := float64(pos.Y - size.Height/2)
+
+	rx, ry := k.radii()
+	dotRadius := float64(fyne.Min(rx, ry)) / 4 // the dot diameter is a quarter of the knob's
+	return math.Hypot(dx, dy) <= dotRadius
+}
+
+// inHybridCenter reports whether the given position lies inside the inner region
+// where a HybridDrag gesture makes fine adjustments.
+func (k *RotatingKnob) inHybridCenter(pos fyne.Position) bool {
+	size := k.Size()
+	dx := float64(pos.X - size.Width/2)
+	dy := float64(pos.Y - size.Height/2)
+
+	rx, ry := k.radii()
+	threshold := float64(k.HybridThreshold)
+	if threshold <= 0 {
+		threshold = defaultKnobHybridThreshold
+	}
+	return math.Hypot(dx, dy) <= float64(fyne.Min(rx, ry))*threshold
+}
+
+// inTapBand reports whether the given position lies within the radial band around
+// the surrounding arc that TapBandOnly restricts taps to.
+func (k *RotatingKnob) inTapBand(pos fyne.Position) bool {
+	size := k.Size()
+	dx := float64(pos.X - size.Width/2)
+	dy := float64(pos.Y - size.Height/2)
+
+	rx, ry := k.radii()
+	if rx <= 0 || ry <= 0 {
+		return false
+	}
+	radius := float64(fyne.Min(rx, ry))
+
+	// distance from the centre, scaled back to the smaller radius for ellipses
+	dist := math.Hypot(dx/float64(rx), dy/float64(ry)) * radius
+
+	band := float64(k.TapBandWidth)
+	if band <= 0 {
+		band = radius * knobArcThickness
+	}
+	mid := radius * (1 - knobArcThickness/2)
+	return math.Abs(dist-mid) <= band
+}
+
+// tickAtPoint finds the nearest tick mark within TickTapRadius of the given position,
+// reporting false when tick snapping is disabled or no tick is close enough.
+func (k *RotatingKnob) tickAtPoint(pos fyne.Position) (int, bool) {
+	if k.TickTapRadius <= 0 || k.tickCount() < 2 {
+		return 0, false
+	}
+
+	nearest, nearestDist := 0, float64(k.TickTapRadius)
+	found := false
+	for i := 0; i < k.tickCount(); i++ {
+		tickPos := k.tickCenter(i)
+		dist := math.Hypot(float64(pos.X-tickPos.X), float64(pos.Y-tickPos.Y))
+		if dist <= nearestDist {
+			nearest, nearestDist = i, dist
+			found = true
+		}
+	}
+	return nearest, found
+}
+
+// Disable disables the knob so it ignores interaction.
+//
+// Since: 2.7
+func (k *RotatingKnob) Disable() {
+	if !k.disabled {
+		defer k.Refresh()
+	}
+	k.disabled = true
+}
+
+// Enable enables the knob so it responds to interaction again.
+//
+// Since: 2.7
+func (k *RotatingKnob) Enable() {
+	if k.disabled {
+		defer k.Refresh()
+	}
+	k.disabled = false
+}
+
+// canInteract reports whether user input should currently drive this knob. Every
+// interaction handler - taps, drags, scrolls and keys alike - checks it first, so
+// new input paths cannot forget to respect the disabled state.
+func (k *RotatingKnob) canInteract() bool {
+	return !k.disabled
+}
+
+// Disabled returns true if the knob is currently disabled.
+//
+// Since: 2.7
+func (k *RotatingKnob) Disabled() bool {
+	return k.disabled
+}
+
+// MinSize returns the size that this widget should not shrink below.
+func (k *RotatingKnob) MinSize() fyne.Size {
+	k.ExtendBaseWidget(k)
+	return k.BaseWidget.MinSize()
+}
+
+// CreateRenderer links this widget to its renderer.
+func (k *RotatingKnob) CreateRenderer() fyne.WidgetRenderer {
+	k.ExtendBaseWidget(k)
+	th := k.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+
+	track := canvas.NewDoughnutArc(k.StartAngle, k.EndAngle, th.Color(theme.ColorNameInputBackground, v))
+	gap := canvas.NewDoughnutArc(k.EndAngle, k.EndAngle, th.Color(theme.ColorNameDisabled, v))
+	active := canvas.NewDoughnutArc(k.StartAngle, k.StartAngle, th.Color(theme.ColorNameForeground, v))
+	track.RingMode, gap.RingMode, active.RingMode = true, true, true // clean edges at any cutout ratio
+	gap.Hide()
+	dot := &canvas.Circle{FillColor: th.Color(theme.ColorNameForeground, v)}
+	centerIcon := &canvas.Image{FillMode: canvas.ImageFillContain}
+	centerIcon.Hide()
+	indicator := canvas.NewLine(th.Color(theme.ColorNameForeground, v))
+	notch := canvas.NewLine(th.Color(theme.ColorNameFocus, v))
+	notch.Hide()
+
+	objects := []fyne.CanvasObject{track, gap, active, notch, dot, centerIcon, indicator}
+
+	r := &rotatingKnobRenderer{
+		BaseRenderer: widget.NewBaseRenderer(objects),
+		track:        track,
+		gap:          gap,
+		active:       active,
+		notch:        notch,
+		dot:          dot,
+		centerIcon:   centerIcon,
+		indicator:    indicator,
+		knob:         k,
+	}
+	r.Refresh() // prepare for first draw
+	return r
+}
+
+// ratio returns how far the current value sits between Min and Max, in the range 0 to 1.
+func (k *RotatingKnob) ratio() float64 {
+	if k.Max == k.Min {
+		return 0
+	}
+	return (k.value - k.Min) / (k.Max - k.Min)
+}
+
+// angleRange returns the configured sweep, falling back to a full rotation when
+// StartAngle and EndAngle coincide so that ratios never divide by a zero sweep.
+func (k *RotatingKnob) angleRange() (start, end float32) {
+	start, end = k.StartAngle, k.EndAngle
+	if start == end {
+		end = start + 360
+	}
+	return start, end
+}
+
+// Geometry returns the centre point and radius the renderer draws this knob with at
+// its current size, so overlays and custom graphics can align with the dial exactly
+// without duplicating the layout maths.
+//
+// Since: 2.7
+func (k *RotatingKnob) Geometry() (center fyne.Position, radius float32) {
+	size := k.Size()
+	center = fyne.NewPos(size.Width/2, size.Height/2)
+	rx, ry := k.radii()
+	return center, fyne.Min(rx, ry)
+}
+
+// ThumbPosition returns the tip of the indicator for the current value and size, in
+// widget-local coordinates. It wraps the renderer's thumb math so overlays such as
+// a value bubble or popover can be anchored precisely to the moving end of the knob.
+//
+// Since: 2.7
+func (k *RotatingKnob) ThumbPosition() fyne.Position {
+	center, radius := k.Geometry()
+	angle := float64(k.angleForValue(k.value)) * math.Pi / 180
+	outer := float64(radius) * float64(1-knobArcThickness)
+	return fyne.NewPos(
+		center.X+float32(math.Sin(angle)*outer),
+		center.Y-float32(math.Cos(angle)*outer))
+}
+
+// mirrored reports whether the angular mapping runs from EndAngle back to StartAngle,
+// as AutoRTL requests for right-to-left locales.
+func (k *RotatingKnob) mirrored() bool {
+	return k.AutoRTL && isLocaleRTL(systemLocale())
+}
+
+// angleForValue maps a value onto the knob sweep, in canvas.Arc degrees.
+func (k *RotatingKnob) angleForValue(value float64) float32 {
+	ratio := float32(0)
+	if k.Max != k.Min {
+		ratio = float32((value - k.Min) / (k.Max - k.Min))
+	}
+	if k.Endless { // show the position modulo the sweep
+		ratio = float32(math.Mod(float64(ratio), 1))
+		if ratio < 0 {
+			ratio++
+		}
+	}
+	if k.mirrored() {
+		ratio = 1 - ratio
+	}
+	start, end := k.angleRange()
+	return start + ratio*(end-start)
+}
+
+// radii returns the horizontal and vertical radii used to draw the knob at its
+// current size. These differ only when AllowEllipse is set and the bounds are not square.
+func (k *RotatingKnob) radii() (rx, ry float32) {
+	size := k.Size()
+	pad := k.Theme().Size(theme.SizeNameInnerPadding)
+	if k.AllowEllipse {
+		rx, ry = (size.Width-pad*2)/2, (size.Height-pad*2)/2
+	} else {
+		rx = (fyne.Min(size.Width, size.Height) - pad*2) / 2
+		ry = rx
+	}
+	if rx < 0 {
+		rx = 0
+	}
+	if ry < 0 {
+		ry = 0
+	}
+	return rx, ry
+}
+
+// valueForPoint maps a pointer position within the widget onto the value range,
+// clamping positions outside the sweep to the nearest end.
+func (k *RotatingKnob) valueForPoint(e *fyne.PointEvent) float64 {
+	size := k.Size()
+	dx := float64(e.Position.X - size.Width/2)
+	dy := float64(e.Position.Y - size.Height/2)
+
+	// normalise by the radii so dragging around an ellipse still feels angular
+	if rx, ry := k.radii(); rx > 0 && ry > 0 {
+		dx /= float64(rx)
+		dy /= float64(ry)
+	}
+
+	angle := math.Atan2(dx, -dy) * 180 / math.Pi // 0 is top, positive is clockwise
+	start, end := k.angleRange()
+
+	value := ValueFromAngle(angle, float64(start), float64(end), k.Min, k.Max, false)
+	if k.mirrored() {
+		value = k.Min + k.Max - value
+	}
+	return value
+}
+
+// nearestStep returns the index of the Steps entry closest to the given value.
+func (k *RotatingKnob) nearestStep(value float64) int {
+	nearest := 0
+	for i, step := range k.Steps {
+		if math.Abs(step-value) < math.Abs(k.Steps[nearest]-value) {
+			nearest = i
+		}
+	}
+	return nearest
+}
+
+func (k *RotatingKnob) clampValueToRange() {
+	if len(k.Steps) > 0 {
+		k.value = k.Steps[k.nearestStep(k.value)]
+		return
+	}
+
+	if k.Endless { // no bounds, the value just snaps to the grid
+		k.snapValue()
+		return
+	}
+
+	if k.Wrapping && k.Max > k.Min && (k.value > k.Max || k.value < k.Min) {
+		span := k.Max - k.Min
+		k.value = k.Min + math.Mod(k.value-k.Min, span)
+		if k.value < k.Min {
+			k.value += span
+		}
+		k.snapValue()
+		return
+	}
+
+	if k.value >= k.Max {
+		k.value = k.Max
+	} else if k.value <= k.Min {
+		k.value = k.Min
+	}
+
+	k.snapValue()
+}
+
+// snapValue rounds the value onto the grid in use: whole numbers when IntegerOnly
+// is set, otherwise Step increments.
+func (k *RotatingKnob) snapValue() {
+	if k.IntegerOnly {
+		k.roundToInteger()
+		return
+	}
+	if k.value == k.Max || k.value == k.Min {
+		return // the range ends are reachable whatever the step grid says
+	}
+	k.snapToStep()
+}
+
+// roundToInteger rounds the value to the nearest whole number, rounding inwards
+// when the nearest integer would leave the range.
+func (k *RotatingKnob) roundToInteger() {
+	rounded := math.Round(k.value)
+	if rounded > k.Max {
+		rounded = math.Floor(k.value)
+	} else if rounded < k.Min {
+		rounded = math.Ceil(k.value)
+	}
+	k.value = rounded
+}
+
+// snapToStep rounds the value to the nearest Step increment from Min, resolving
+// values halfway between two steps according to the Rounding mode.
+func (k *RotatingKnob) snapToStep() {
+	if k.Step == 0 { // extended RotatingKnob may not have this set - assume value is not adjusted
+		return
+	}
+
+	rem := math.Mod(k.value-k.Min, k.Step)
+	if rem == 0 {
+		return
+	}
+
+	switch k.Rounding {
+	case RoundHalfUp:
+		k.value = k.Min + math.Floor((k.value-k.Min)/k.Step+0.5)*k.Step
+	case RoundHalfEven:
+		k.value = k.Min + math.RoundToEven((k.value-k.Min)/k.Step)*k.Step
+	default:
+		value := k.value - rem
+		if rem > k.Step/2 {
+			value += k.Step
+		} else if rem < -k.Step/2 { // negative values only occur in Endless mode
+			value -= k.Step
+		}
+		k.value = value
+	}
+}
+
+func (k *RotatingKnob) positionChanged(lastValue, currentValue float64) {
+	if k.almostEqual(lastValue, currentValue) {
+		return
+	}
+
+	k.Refresh()
+
+	k.pendingChange = true
+	if k.MinChangeDelta <= 0 || math.Abs(k.value-k.lastNotified) >= k.MinChangeDelta {
+		k.lastNotified = k.value
+		if k.OnChanged != nil {
+			k.OnChanged(k.value)
+		}
+	}
+	if k.OnValueFormatted != nil && k.ValueFormatter != nil {
+		k.OnValueFormatted(k.value, k.ValueFormatter(k.value))
+	}
+	if k.OnDelta != nil {
+		k.OnDelta(currentValue - lastValue)
+	}
+	k.lastChange = k.changeSource
+	if k.changeLogger != nil {
+		k.changeLogger(time.Now(), k.value, k.changeSource)
+	}
+	k.checkBoundary()
+	k.propagateToLinked()
+}
+
+// checkBoundary fires OnBoundary the first time the value hits Min or Max, then waits
+// for it to move away before arming again so it cannot fire repeatedly while the
+// pointer stays past the end of the range.
+func (k *RotatingKnob) checkBoundary() {
+	atMin, atMax := k.value == k.Min, k.value == k.Max
+	if atMin || atMax {
+		if !k.atBoundary {
+			k.atBoundary = true
+			if k.OnBoundary != nil {
+				k.OnBoundary(atMax)
+			}
+			k.announceBoundary(atMax)
+		}
+		return
+	}
+	k.atBoundary = false
+}
+
+func (k *RotatingKnob) fireChangeEnded() {
+	if !k.pendingChange {
+		return
+	}
+	k.pendingChange = false
+	k.lastNotified = k.value // the final value always reaches the callbacks
+	if k.OnChangeEnded != nil {
+		k.OnChangeEnded(k.value)
+	}
+	if k.OnChangeEndedSource != nil {
+		k.OnChangeEndedSource(k.value, k.lastChange)
+	}
+	k.announceStatus()
+}
+
+func (k *RotatingKnob) announceStatus() {
+	if k.StatusBinding == nil {
+		return
+	}
+	name := k.StatusName
+	if name == "" {
+		name = "Value"
+	}
+	status := name + " set to " + strconv.FormatFloat(k.value, 'f', -1, 64)
+	if err := k.StatusBinding.Set(status); err != nil {
+		fyne.LogError("Failed to set knob status binding", err)
+	}
+}
+
+// announceBoundary writes the end-of-range message to the StatusBinding, so
+// accessible status output says more than the bare value when the range runs out.
+func (k *RotatingKnob) announceBoundary(atMax bool) {
+	if k.StatusBinding == nil {
+		return
+	}
+	status := k.MinReachedText
+	if status == "" {
+		status = "Minimum reached"
+	}
+	if atMax {
+		status = k.MaxReachedText
+		if status == "" {
+			status = "Maximum reached"
+		}
+	}
+	if err := k.StatusBinding.Set(status); err != nil {
+		fyne.LogError("Failed to set knob status binding", err)
+	}
+}
+
+func (k *RotatingKnob) almostEqual(a, b float64) bool {
+	return math.Abs(a-b) <= k.Step/2
+}
+
+type rotatingKnobRenderer struct {
+	widget.BaseRenderer
+	track      *canvas.Arc
+	gap        *canvas.Arc
+	active     *canvas.Arc
+	wedge      []*canvas.Arc
+	ticks      []*canvas.Line
+	labels     []*canvas.Text
+	tickLabels []*canvas.Text
+	dot        *canvas.Circle
+	centerIcon *canvas.Image
+	indicator  *canvas.Line
+	notch      *canvas.Line
+	knob       *RotatingKnob
+
+	laidOut      fyne.Size  // size the static geometry was last laid out for
+	laidOutSweep [2]float32 // angles the static geometry was last laid out for
+}
+
+// MinSize calculates the minimum size of the knob.
+func (r *rotatingKnobRenderer) MinSize() fyne.Size {
+	th := r.knob.Theme()
+	diameter := th.Size(theme.SizeNameInlineIcon)*2 + th.Size(theme.SizeNameInnerPadding)*2
+	return fyne.NewSquareSize(fyne.Max(diameter, r.knob.MinDiameter))
+}
+
+// Layout the components of the knob.
+func (r *rotatingKnobRenderer) Layout(size fyne.Size) {
+	th := r.knob.Theme()
+	pad := th.Size(theme.SizeNameInnerPadding)
+
+	diameter := fyne.Min(size.Width, size.Height) - pad*2
+	if diameter < 0 {
+		diameter = 0
+	}
+	radius := diameter / 2
+	center := fyne.NewPos(size.Width/2, size.Height/2)
+	dotDiameter := diameter / 4
+
+	threshold := r.knob.CompactThreshold
+	if threshold == 0 {
+		threshold = defaultKnobCompactThreshold
+	}
+	compact := diameter < threshold
+
+	// everything except the indicator depends only on the size, so a Refresh for a
+	// moving value repositions just the indicator and skips the work below
+	if size != r.laidOut || r.laidOutSweep != [2]float32{r.knob.StartAngle, r.knob.EndAngle} {
+		r.laidOut = size
+		r.laidOutSweep = [2]float32{r.knob.StartAngle, r.knob.EndAngle}
+
+		// canvas.Arc is centred on its position
+		arcSize := fyne.NewSquareSize(diameter)
+		for _, seg := range r.wedge {
+			seg.Move(center)
+			seg.Resize(arcSize)
+		}
+		r.track.Move(center)
+		r.track.Resize(arcSize)
+		r.gap.Move(center)
+		r.gap.Resize(arcSize)
+		r.active.Move(center)
+		r.active.Resize(arcSize)
+
+		dotCorner := fyne.NewPos(center.X-dotDiameter/2, center.Y-dotDiameter/2)
+		dotSize := fyne.NewSquareSize(dotDiameter)
+		r.dot.Move(dotCorner)
+		r.dot.Resize(dotSize)
+		r.centerIcon.Move(dotCorner)
+		r.centerIcon.Resize(dotSize)
+
+		setVisible(r.dot, !compact)
+		for _, tick := range r.ticks {
+			setVisible(tick, !compact)
+		}
+		for _, label := range r.labels {
+			setVisible(label, !compact)
+		}
+		for _, label := range r.tickLabels {
+			setVisible(label, !compact)
+		}
+
+		r.layoutTicks(center, radius)
+		r.layoutTickLabels(center, radius)
+		r.layoutStepLabels(center, radius)
+	}
+
+	r.layoutDynamic(size)
+}
+
+// layoutDynamic places the geometry that follows the current value - the indicator
+// and the centre icon's visibility - without touching the static layout. Refresh
+// calls it directly so a SetValue does not pay for a full Layout pass.
+func (r *rotatingKnobRenderer) layoutDynamic(size fyne.Size) {
+	th := r.knob.Theme()
+	pad := th.Size(theme.SizeNameInnerPadding)
+
+	diameter := fyne.Min(size.Width, size.Height) - pad*2
+	if diameter < 0 {
+		diameter = 0
+	}
+	radius := diameter / 2
+	center := fyne.NewPos(size.Width/2, size.Height/2)
+
+	threshold := r.knob.CompactThreshold
+	if threshold == 0 {
+		threshold = defaultKnobCompactThreshold
+	}
+	setVisible(r.centerIcon, diameter >= threshold && r.knob.CenterIcon != nil)
+
+	angle := float64(r.knob.angleForValue(r.knob.value)) * math.Pi / 180
+	sin, cos := math.Sin(angle), math.Cos(angle)
+	inner := float64(diameter / 8)
+	outer := float64(radius) * float64(1-knobArcThickness)
+	r.indicator.Position1 = fyne.NewPos(center.X+float32(sin*inner), center.Y-float32(cos*inner))
+	r.indicator.Position2 = fyne.NewPos(center.X+float32(sin*outer), center.Y-float32(cos*outer))
+
+	if r.knob.Notch != nil { // a short radial cut across the track at the detent
+		notchAngle := float64(r.knob.angleForValue(*r.knob.Notch)) * math.Pi / 180
+		sin, cos = math.Sin(notchAngle), math.Cos(notchAngle)
+		notchInner := float64(radius) * (1 - knobArcThickness*2)
+		r.notch.Position1 = fyne.NewPos(center.X+float32(sin*notchInner), center.Y-float32(cos*notchInner))
+		r.notch.Position2 = fyne.NewPos(center.X+float32(sin)*radius, center.Y-float32(cos)*radius)
+	}
+}
+
+// trackCutout returns the CutoutRatio giving the arcs their stroke width at the
+// knob's current size: TrackThicknessRatio of the radius when set, otherwise a
+// stroke proportional to the diameter clamped between knobMinStrokeWidth and
+// knobMaxStrokeWidth.
+func (r *rotatingKnobRenderer) trackCutout() float32 {
+	_, radius := r.knob.Geometry()
+	if radius <= 0 {
+		return 0
+	}
+
+	thickness := radius * r.knob.TrackThicknessRatio
+	if r.knob.TrackThicknessRatio <= 0 {
+		thickness = radius * 2 * knobStrokeRatio
+		if thickness < knobMinStrokeWidth {
+			thickness = knobMinStrokeWidth
+		} else if thickness > knobMaxStrokeWidth {
+			thickness = knobMaxStrokeWidth
+		}
+	}
+	if r.knob.HighContrast {
+		thickness *= knobHighContrastScale
+	}
+
+	cutout := 1 - thickness/radius
+	if cutout < 0 {
+		cutout = 0
+	}
+	return cutout
+}
+
+// setVisible shows or hides the given object to match the wanted state.
+func setVisible(obj fyne.CanvasObject, visible bool) {
+	if visible {
+		obj.Show()
+	} else {
+		obj.Hide()
+	}
+}
+
+// Refresh updates the knob state for drawing.
+func (r *rotatingKnobRenderer) Refresh() {
+	th := r.knob.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+
+	foreground := th.Color(theme.ColorNameForeground, v)
+	trackColor := th.Color(theme.ColorNameInputBackground, v)
+	if r.knob.ValueColorFunc != nil {
+		if valueColor := r.knob.ValueColorFunc(r.knob.value); valueColor != nil {
+			foreground = valueColor
+		}
+	}
+	if r.knob.disabled {
+		foreground = th.Color(theme.ColorNameDisabled, v)
+		if r.knob.DisabledColor != nil {
+			foreground = r.knob.DisabledColor
+		}
+		trackColor = th.Color(theme.ColorNameDisabledButton, v)
+	}
+	if r.knob.HighContrast {
+		foreground = theme.AccessiblePalette.Foreground
+		trackColor = theme.AccessiblePalette.Background
+	}
+
+	start, end := r.knob.angleRange()
+	cutout := r.trackCutout()
+	r.track.FillColor = trackColor
+	r.track.StartAngle = start
+	r.track.EndAngle = end
+	r.track.CutoutRatio = cutout
+	r.gap.CutoutRatio = cutout
+	r.active.CutoutRatio = cutout
+
+	sweep := canvas.ArcSweep(start, end)
+	unused := 360 - float32(math.Abs(float64(sweep)))
+	if r.knob.ShowGap && unused > 0 {
+		gapColor := r.knob.GapColor
+		if gapColor == nil {
+			dr, dg, db, _ := th.Color(theme.ColorNameDisabled, v).RGBA()
+			gapColor = color.RGBA64{R: uint16(dr), G: uint16(dg), B: uint16(db), A: 0x1fff}
+		}
+		r.gap.FillColor = gapColor
+		r.gap.StartAngle = end
+		if sweep < 0 {
+			r.gap.EndAngle = end - unused
+		} else {
+			r.gap.EndAngle = end + unused
+		}
+		r.gap.Show()
+	} else {
+		r.gap.Hide()
+	}
+
+	r.active.FillColor = foreground
+	glow := r.knob.glowFrac
+	if r.knob.fineMode {
+		glow = 1
+	}
+	if FocusRingVisible(r.knob.focused, r.knob.lastModality) {
+		glow = 1
+	}
+	if glow > 0 && !r.knob.disabled {
+		r.active.GlowColor = th.Color(theme.ColorNameFocus, v)
+		r.active.GlowWidth = th.Size(theme.SizeNameInputBorder) * 2 * glow
+		if r.knob.HighContrast {
+			r.active.GlowColor = theme.AccessiblePalette.Focus
+			r.active.GlowWidth *= knobHighContrastScale
+		}
+	} else {
+		r.active.GlowColor = nil
+	}
+	if r.knob.Inverted {
+		r.active.StartAngle = r.knob.angleForValue(r.knob.value)
+		r.active.EndAngle = end
+	} else {
+		r.active.StartAngle = start
+		r.active.EndAngle = r.knob.angleForValue(r.knob.value)
+	}
+
+	if r.knob.Notch != nil {
+		r.notch.StrokeColor = th.Color(theme.ColorNameFocus, v)
+		if r.knob.HighContrast {
+			r.notch.StrokeColor = theme.AccessiblePalette.Focus
+		}
+		r.notch.StrokeWidth = th.Size(theme.SizeNameInputBorder) * 2
+		r.notch.Show()
+	} else {
+		r.notch.Hide()
+	}
+
+	r.dot.FillColor = foreground
+	r.centerIcon.Resource = r.knob.CenterIcon
+	r.indicator.StrokeColor = foreground
+	r.indicator.StrokeWidth = th.Size(theme.SizeNameInputBorder) * 2
+	if r.knob.HighContrast {
+		r.indicator.StrokeWidth *= knobHighContrastScale
+	}
+	if (r.knob.HideIndicatorAtMin && r.knob.value == r.knob.Min) ||
+		(r.knob.HideIndicatorAtMax && r.knob.value == r.knob.Max) {
+		r.indicator.Hide()
+	} else {
+		r.indicator.Show()
+	}
+
+	r.updateTicks()
+
+	r.knob.clampValueToRange()
+	// rebuilt, never laid out or re-swept objects need the full pass
+	if r.knob.Size() != r.laidOut || r.laidOutSweep != [2]float32{r.knob.StartAngle, r.knob.EndAngle} {
+		r.Layout(r.knob.Size())
+	} else {
+		r.layoutDynamic(r.knob.Size())
+	}
+	canvas.Refresh(r.knob.super())
+}
+
+// knobArcThickness is the portion of the knob radius taken up by the surrounding arc.
+const knobArcThickness = 0.15
+
+// knobStrokeRatio is the portion of the diameter the default track stroke occupies.
+const knobStrokeRatio = 0.08
+
+// knobMinStrokeWidth and knobMaxStrokeWidth bound the scaled default stroke, in
+// pixels, so tiny knobs do not look chunky and huge ones do not look wiry.
+const (
+	knobMinStrokeWidth = 4
+	knobMaxStrokeWidth = 16
+)
+
+// knobHighContrastScale is how much HighContrast widens strokes and the focus glow.
+const knobHighContrastScale = 1.5
+
+// knobWedgeSegments is how many flat-colour slices approximate the wedge gradient.
+const knobWedgeSegments = 24
+
+// lerpColor blends linearly from one colour to another, t in [0, 1].
+func lerpColor(from, to color.Color, t float32) color.Color {
+	fr, fg, fb, fa := from.RGBA()
+	tr, tg, tb, ta := to.RGBA()
+	mix := func(a, b uint32) uint16 { return uint16(float32(a) + (float32(b)-float32(a))*t) }
+	return color.RGBA64{R: mix(fr, tr), G: mix(fg, tg), B: mix(fb, tb), A: mix(fa, ta)}
+}
+
+func (r *rotatingKnobRenderer) layoutTicks(center fyne.Position, radius float32) {
+	if len(r.ticks) == 0 {
+		return
+	}
+
+	inner := float64(radius) * (1 - knobArcThickness*2)
+	outer := float64(radius)
+	sweep := canvas.ArcSweep(r.knob.StartAngle, r.knob.EndAngle)
+	for i, tick := range r.ticks {
+		var angle float64
+		if positions := r.knob.TickPositions; len(positions) == len(r.ticks) {
+			angle = float64(r.knob.angleForValue(positions[i])) * math.Pi / 180
+		} else {
+			ratio := float32(i) / float32(len(r.ticks)-1)
+			angle = float64(r.knob.StartAngle+ratio*sweep) * math.Pi / 180
+		}
+		sin, cos := math.Sin(angle), math.Cos(angle)
+		tick.Position1 = fyne.NewPos(center.X+float32(sin*inner), center.Y-float32(cos*inner))
+		tick.Position2 = fyne.NewPos(center.X+float32(sin*outer), center.Y-float32(cos*outer))
+	}
+}
+
+func (r *rotatingKnobRenderer) updateTicks() {
+	th := r.knob.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+
+	count := r.knob.tickCount()
+	if count < 0 {
+		count = 0
+	}
+	labelCount := len(r.knob.StepLabels)
+	if len(r.knob.Steps) == 0 {
+		labelCount = 0
+	}
+	tickLabelCount := 0
+	if count > 0 && len(r.knob.TickLabels) >= count { // short slices draw no labels
+		tickLabelCount = count
+	}
+	wedgeCount := 0
+	if r.knob.WedgeGradient[0] != nil && r.knob.WedgeGradient[1] != nil {
+		wedgeCount = knobWedgeSegments
+	} else if r.knob.WedgeColor != nil {
+		wedgeCount = 1
+	}
+	if count != len(r.ticks) || labelCount != len(r.labels) || tickLabelCount != len(r.tickLabels) ||
+		wedgeCount != len(r.wedge) {
+		r.wedge = make([]*canvas.Arc, wedgeCount)
+		for i := range r.wedge {
+			r.wedge[i] = canvas.NewPieArc(0, 0, r.knob.WedgeColor)
+		}
+		r.ticks = make([]*canvas.Line, count)
+		for i := range r.ticks {
+			r.ticks[i] = canvas.NewLine(th.Color(theme.ColorNameShadow, v))
+		}
+		r.labels = make([]*canvas.Text, labelCount)
+		for i := range r.labels {
+			r.labels[i] = canvas.NewText(r.knob.StepLabels[i], th.Color(theme.ColorNameForeground, v))
+		}
+		r.tickLabels = make([]*canvas.Text, tickLabelCount)
+		for i := range r.tickLabels {
+			r.tickLabels[i] = canvas.NewText(r.knob.TickLabels[i], th.Color(theme.ColorNameForeground, v))
+		}
+
+		objects := make([]fyne.CanvasObject, 0, wedgeCount+2)
+		for _, seg := range r.wedge { // wedge sits beneath the track
+			objects = append(objects, seg)
+		}
+		objects = append(objects, r.track, r.gap, r.active)
+		for _, tick := range r.ticks {
+			objects = append(objects, tick)
+		}
+		for _, label := range r.tickLabels {
+			objects = append(objects, label)
+		}
+		for _, label := range r.labels {
+			objects = append(objects, label)
+		}
+		r.SetObjects(append(objects, r.notch, r.dot, r.centerIcon, r.indicator))
+		r.laidOut = fyne.Size{} // rebuilt objects must be laid out from scratch
+	}
+
+	if len(r.wedge) > 0 {
+		wedgeStart, _ := r.knob.angleRange()
+		wedgeEnd := r.knob.angleForValue(r.knob.value)
+		for i, seg := range r.wedge {
+			from := float32(i) / float32(len(r.wedge))
+			to := float32(i+1) / float32(len(r.wedge))
+			seg.StartAngle = wedgeStart + from*(wedgeEnd-wedgeStart)
+			seg.EndAngle = wedgeStart + to*(wedgeEnd-wedgeStart)
+			if len(r.wedge) == 1 {
+				seg.FillColor = r.knob.WedgeColor
+			} else {
+				seg.FillColor = lerpColor(r.knob.WedgeGradient[0], r.knob.WedgeGradient[1], (from+to)/2)
+			}
+		}
+	}
+
+	tickColor := th.Color(theme.ColorNameShadow, v)
+	if r.knob.TickColor != nil {
+		tickColor = r.knob.TickColor
+	}
+	if r.knob.disabled {
+		tickColor = th.Color(theme.ColorNameDisabled, v)
+		if r.knob.DisabledColor != nil {
+			tickColor = r.knob.DisabledColor
+		}
+	}
+	for _, tick := range r.ticks {
+		tick.StrokeColor = tickColor
+		tick.StrokeWidth = th.Size(theme.SizeNameInputBorder)
+	}
+	for i, label := range r.labels {
+		label.Text = r.knob.StepLabels[i]
+		label.Color = th.Color(theme.ColorNameForeground, v)
+		label.TextSize = th.Size(theme.SizeNameCaptionText)
+	}
+	for i, label := range r.tickLabels {
+		label.Text = r.knob.TickLabels[i]
+		label.Color = th.Color(theme.ColorNameForeground, v)
+		label.TextSize = th.Size(theme.SizeNameCaptionText)
+	}
+}
+
+// layoutStepLabels places the StepLabels beside their matching step positions,
+// just outside the arc within the widget padding.
+func (r *rotatingKnobRenderer) layoutTickLabels(center fyne.Position, radius float32) {
+	if len(r.tickLabels) == 0 {
+		return
+	}
+
+	// labels sit just inside the tick marks, like the numbers on a clock face
+	reach := float64(radius)*(1-knobArcThickness*2) - float64(r.knob.Theme().Size(theme.SizeNameInnerPadding))
+	sweep := canvas.ArcSweep(r.knob.StartAngle, r.knob.EndAngle)
+	for i, label := range r.tickLabels {
+		var angle float64
+		if positions := r.knob.TickPositions; len(positions) == len(r.tickLabels) {
+			angle = float64(r.knob.angleForValue(positions[i])) * math.Pi / 180
+		} else {
+			ratio := float32(i) / float32(len(r.tickLabels)-1)
+			angle = float64(r.knob.StartAngle+ratio*sweep) * math.Pi / 180
+		}
+		size := label.MinSize()
+		label.Move(fyne.NewPos(
+			center.X+float32(math.Sin(angle)*reach)-size.Width/2,
+			center.Y-float32(math.Cos(angle)*reach)-size.Height/2))
+		label.Resize(size)
+	}
+}
+
+func (r *rotatingKnobRenderer) layoutStepLabels(center fyne.Position, radius float32) {
+	if len(r.labels) == 0 {
+		return
+	}
+
+	reach := float64(radius) + float64(r.knob.Theme().Size(theme.SizeNameInnerPadding))/2
+	for i, label := range r.labels {
+		if i >= len(r.knob.Steps) {
+			label.Hide()
+			continue
+		}
+		angle := float64(r.knob.angleForValue(r.knob.Steps[i])) * math.Pi / 180
+		size := label.MinSize()
+		label.Move(fyne.NewPos(
+			center.X+float32(math.Sin(angle)*reach)-size.Width/2,
+			center.Y-float32(math.Cos(angle)*reach)-size.Height/2))
+		label.Resize(size)
+	}
+}
@@ -0,0 +1,1754 @@
+package widget
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/driver/mobile"
+	"fyne.io/fyne/v2/lang"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRotatingKnob_SetValue(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	assert.Equal(t, 0.0, knob.Value())
+
+	knob.SetValue(50)
+	assert.Equal(t, 50.0, knob.Value())
+}
+
+func TestRotatingKnob_SetValue_Clamped(t *testing.T) {
+	knob := NewRotatingKnob(0, 10)
+
+	knob.SetValue(20)
+	assert.Equal(t, 10.0, knob.Value())
+
+	knob.SetValue(-5)
+	assert.Equal(t, 0.0, knob.Value())
+}
+
+func TestRotatingKnob_OnChanged(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+
+	changes := 0
+	knob.OnChanged = func(_ float64) {
+		changes++
+	}
+	knob.SetValue(25)
+	assert.Equal(t, 1, changes)
+
+	knob.SetValue(25)
+	assert.Equal(t, 1, changes)
+}
+
+func TestRotatingKnob_Dragged(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.Resize(fyne.NewSize(100, 100))
+
+	// drag to the top of the knob, the middle of the default sweep
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(50, 10)}})
+	assert.Equal(t, 50.0, knob.Value())
+}
+
+func TestRotatingKnob_Disabled(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.Resize(fyne.NewSize(100, 100))
+	knob.Disable()
+	assert.True(t, knob.Disabled())
+
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(50, 10)}})
+	assert.Equal(t, 0.0, knob.Value())
+
+	knob.Enable()
+	assert.False(t, knob.Disabled())
+}
+
+func TestRotatingKnob_HideIndicatorAtMin(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.HideIndicatorAtMin = true
+	render := test.TempWidgetRenderer(t, knob).(*rotatingKnobRenderer)
+
+	assert.Equal(t, 0.0, knob.Value())
+	assert.False(t, render.indicator.Visible())
+
+	knob.SetValue(50)
+	assert.True(t, render.indicator.Visible())
+
+	knob.HideIndicatorAtMax = true
+	knob.SetValue(100)
+	assert.False(t, render.indicator.Visible())
+}
+
+func TestRotatingKnob_Scrolled(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+
+	knob.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, 1)})
+	assert.Equal(t, 1.0, knob.Value())
+
+	knob.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, -1)})
+	assert.Equal(t, 0.0, knob.Value())
+
+	knob.ScrollStepMultiplier = 5
+	knob.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, 1)})
+	assert.Equal(t, 5.0, knob.Value())
+}
+
+func TestRotatingKnob_ScrollAcceleration(t *testing.T) {
+	knob := NewRotatingKnob(0, 1000)
+	knob.ScrollAcceleration = true
+
+	knob.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, 1)})
+	assert.Equal(t, 1.0, knob.Value())
+
+	// rapid successive notches double the effective step
+	knob.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, 1)})
+	assert.Equal(t, 3.0, knob.Value())
+	knob.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, 1)})
+	assert.Equal(t, 7.0, knob.Value())
+
+	// an idle gap resets the acceleration
+	knob.lastScroll = knob.lastScroll.Add(-time.Second)
+	knob.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, 1)})
+	assert.Equal(t, 8.0, knob.Value())
+}
+
+func TestRotatingKnob_Inverted(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.Inverted = true
+	render := test.TempWidgetRenderer(t, knob).(*rotatingKnobRenderer)
+
+	knob.SetValue(25)
+	quarter := render.active.EndAngle - render.active.StartAngle
+
+	knob.SetValue(75)
+	threeQuarters := render.active.EndAngle - render.active.StartAngle
+
+	// the active arc shrinks as the value grows, depleting towards EndAngle
+	assert.Less(t, threeQuarters, quarter)
+	assert.Equal(t, knob.EndAngle, render.active.EndAngle)
+
+	knob.SetValue(100)
+	assert.Equal(t, render.active.StartAngle, render.active.EndAngle)
+}
+
+func TestRotatingKnob_BatchUpdate(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	render := test.TempWidgetRenderer(t, knob).(*rotatingKnobRenderer)
+
+	knob.BeginUpdate()
+	knob.SetValue(50)
+	knob.TickCount = 5
+	assert.Equal(t, knob.StartAngle, render.active.EndAngle, "no refresh while updating")
+
+	knob.EndUpdate()
+	assert.Equal(t, knob.angleForValue(50), render.active.EndAngle)
+	assert.Len(t, render.ticks, 5)
+
+	// EndUpdate without pending changes does not refresh again
+	knob.EndUpdate()
+}
+
+func TestRotatingKnob_StatusBinding(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.StatusName = "Volume"
+	status := binding.NewString()
+	knob.StatusBinding = status
+
+	knob.SetValue(5)
+	s, err := status.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "Volume set to 5", s)
+
+	knob.StatusName = ""
+	knob.Step = 0.5
+	knob.SetValue(7.5)
+	s, err = status.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "Value set to 7.5", s)
+}
+
+func TestRotatingKnob_CreateRendererFiresNoChange(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.SetValue(40)
+	calls := 0
+	knob.OnChanged = func(float64) { calls++ }
+
+	render := test.TempWidgetRenderer(t, knob).(*rotatingKnobRenderer)
+	knob.Resize(fyne.NewSize(100, 100))
+	render.Refresh()
+	assert.Zero(t, calls, "rendering alone must not report a change")
+
+	knob.SetValue(60)
+	assert.Equal(t, 1, calls, "real changes still report")
+}
+
+func TestRotatingKnob_TickPositions(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.TickCount = 7
+	knob.TickPositions = []float64{0, 10, 30, 100}
+	knob.Resize(fyne.NewSize(100, 100))
+	render := test.TempWidgetRenderer(t, knob).(*rotatingKnobRenderer)
+	render.Refresh()
+
+	assert.Len(t, render.ticks, 4, "explicit positions override TickCount")
+
+	_, radius := knob.Geometry()
+	for i, value := range knob.TickPositions {
+		angle := float64(knob.angleForValue(value)) * math.Pi / 180
+		tip := render.ticks[i].Position2
+		assert.InDelta(t, 50+math.Sin(angle)*float64(radius), float64(tip.X), 0.01)
+		assert.InDelta(t, 50-math.Cos(angle)*float64(radius), float64(tip.Y), 0.01)
+	}
+
+	// clearing the positions restores TickCount's uniform spacing
+	knob.TickPositions = nil
+	render.Refresh()
+	assert.Len(t, render.ticks, 7)
+}
+
+func TestRotatingKnob_Notch(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.Resize(fyne.NewSize(100, 100))
+	render := test.TempWidgetRenderer(t, knob).(*rotatingKnobRenderer)
+	render.Refresh()
+	assert.False(t, render.notch.Visible(), "no notch is drawn by default")
+
+	centre := 50.0
+	knob.Notch = &centre
+	render.Refresh()
+	assert.True(t, render.notch.Visible())
+
+	// the middle of the default sweep is straight up
+	_, radius := knob.Geometry()
+	assert.InDelta(t, 50, float64(render.notch.Position2.X), 0.01)
+	assert.InDelta(t, 50-float64(radius), float64(render.notch.Position2.Y), 0.01)
+	assert.Less(t, render.notch.Position1.Y, fyne.NewPos(50, 50).Y, "the cut runs radially across the track")
+
+	knob.Notch = nil
+	render.Refresh()
+	assert.False(t, render.notch.Visible())
+}
+
+func TestRotatingKnob_StatusBinding_Boundary(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.Resize(fyne.NewSize(100, 100))
+	knob.SetValue(50)
+	status := binding.NewString()
+	knob.StatusBinding = status
+
+	// drag to the end of the sweep, hitting Max mid-gesture
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(78, 78)}})
+	s, err := status.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "Maximum reached", s)
+
+	// move away to re-arm, then hit Min with a custom message
+	knob.MinReachedText = "All the way down"
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(50, 10)}})
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(22, 78)}})
+	s, err = status.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "All the way down", s)
+	knob.DragEnd()
+}
+
+func TestRotatingKnob_TapNearTick(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.TickCount = 11
+	knob.TickTapRadius = 8
+	knob.Resize(fyne.NewSize(100, 100))
+
+	// the middle tick sits at the top of the default sweep
+	top := knob.tickCenter(5)
+	near := fyne.NewPos(top.X+4, top.Y+3)
+	knob.Tapped(&fyne.PointEvent{Position: near})
+	assert.Equal(t, 50.0, knob.Value())
+
+	// outside the hit radius the tap rotates towards the pointer as before
+	knob.TickTapRadius = 1
+	knob.Tapped(&fyne.PointEvent{Position: fyne.NewPos(90, 50)})
+	assert.Equal(t, 83.0, knob.Value())
+}
+
+func TestRotatingKnob_LinkTo(t *testing.T) {
+	first := NewRotatingKnob(0, 100)
+	second := NewRotatingKnob(0, 100)
+	first.LinkTo(second)
+
+	first.SetValue(40)
+	assert.Equal(t, 40.0, second.Value())
+
+	second.SetValue(70)
+	assert.Equal(t, 70.0, first.Value())
+}
+
+func TestRotatingKnob_LinkTo_Disabled(t *testing.T) {
+	first := NewRotatingKnob(0, 100)
+	second := NewRotatingKnob(0, 100)
+	first.Resize(fyne.NewSize(100, 100))
+	second.Resize(fyne.NewSize(100, 100))
+	first.LinkTo(second)
+	second.Disable()
+
+	// a disabled link partner still follows changes driven from the other knob
+	first.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(50, 10)}})
+	assert.Equal(t, 50.0, second.Value())
+
+	// interaction with the disabled knob does not drive its partner
+	second.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(90, 50)}})
+	assert.Equal(t, 50.0, second.Value())
+	assert.Equal(t, 50.0, first.Value())
+}
+
+func TestRotatingKnob_Steps(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.Steps = []float64{0, 25, 80, 100}
+	knob.Resize(fyne.NewSize(100, 100))
+
+	knob.SetValue(30)
+	assert.Equal(t, 25.0, knob.Value())
+
+	// scrolling moves to the adjacent enumerated value, not by Step
+	knob.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, 1)})
+	assert.Equal(t, 80.0, knob.Value())
+
+	// keyboard arrows move between entries too
+	knob.TypedKey(&fyne.KeyEvent{Name: fyne.KeyLeft})
+	assert.Equal(t, 25.0, knob.Value())
+	knob.TypedKey(&fyne.KeyEvent{Name: fyne.KeyDown})
+	assert.Equal(t, 0.0, knob.Value())
+	knob.TypedKey(&fyne.KeyEvent{Name: fyne.KeyDown})
+	assert.Equal(t, 0.0, knob.Value())
+}
+
+func TestRotatingKnob_StepLabels(t *testing.T) {
+	knob := NewRotatingKnob(0, 2)
+	knob.Steps = []float64{0, 1, 2}
+	knob.StepLabels = []string{"Off", "Eco", "Max"}
+	knob.Resize(fyne.NewSize(100, 100))
+	render := test.TempWidgetRenderer(t, knob).(*rotatingKnobRenderer)
+
+	assert.Len(t, render.labels, 3)
+	assert.Equal(t, "Eco", render.labels[1].Text)
+	// the middle label sits above the centre on the default sweep
+	assert.Less(t, render.labels[1].Position().Y, float32(50))
+}
+
+func TestRotatingKnob_ChangeEndedOnBlur(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.Resize(fyne.NewSize(100, 100))
+
+	ended := 0
+	knob.OnChangeEnded = func(_ float64) {
+		ended++
+	}
+
+	// losing focus mid-gesture ends the pending change
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(50, 10)}})
+	knob.FocusLost()
+	assert.Equal(t, 1, ended)
+
+	// a DragEnd arriving afterwards does not fire a second time
+	knob.DragEnd()
+	assert.Equal(t, 1, ended)
+}
+
+func TestRotatingKnob_DragContinuesOutsideBounds(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.Resize(fyne.NewSize(100, 100))
+
+	ended := 0
+	knob.OnChangeEnded = func(_ float64) {
+		ended++
+	}
+
+	// leaving without dragging does nothing
+	knob.MouseIn(&desktop.MouseEvent{})
+	knob.MouseOut()
+	assert.Equal(t, 0, ended)
+
+	// the pointer exits mid-drag; the captured drag keeps tracking
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(50, 10)}})
+	knob.MouseOut()
+	assert.Equal(t, 0, ended, "an exited drag is not ended early")
+	assert.True(t, knob.IsDragging())
+
+	// events outside the rectangle still steer the knob
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(150, 50)}})
+	assert.Equal(t, 83.0, knob.Value(), "positions outside the bounds keep tracking")
+
+	// re-entering and releasing ends the change exactly once
+	knob.MouseIn(&desktop.MouseEvent{})
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(50, 10)}})
+	knob.DragEnd()
+	assert.Equal(t, 1, ended)
+}
+
+func TestRotatingKnob_OnBoundary(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.Resize(fyne.NewSize(100, 100))
+
+	var hits []bool
+	knob.OnBoundary = func(atMax bool) {
+		hits = append(hits, atMax)
+	}
+
+	// dragging past the end fires once, not once per event
+	end := fyne.NewPos(85, 85) // at EndAngle, clamps to Max
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(90, 50)}})
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: end}})
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: end}})
+	assert.Equal(t, []bool{true}, hits)
+
+	// leaving the boundary re-arms it
+	knob.SetValue(50)
+	knob.SetValue(0)
+	assert.Equal(t, []bool{true, false}, hits)
+}
+
+func TestRotatingKnob_TapBandOnly(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.TapBandOnly = true
+	knob.Resize(fyne.NewSize(100, 100))
+
+	// taps on the centre dot or outside the ring are ignored
+	knob.Tapped(&fyne.PointEvent{Position: fyne.NewPos(50, 50)})
+	assert.Equal(t, 0.0, knob.Value())
+	knob.Tapped(&fyne.PointEvent{Position: fyne.NewPos(60, 50)})
+	assert.Equal(t, 0.0, knob.Value())
+
+	// a tap on the ring still jumps the value
+	knob.Tapped(&fyne.PointEvent{Position: fyne.NewPos(90, 50)})
+	assert.Equal(t, 83.0, knob.Value())
+
+	// a wide band accepts taps further from the track
+	knob.TapBandWidth = 30
+	knob.Tapped(&fyne.PointEvent{Position: fyne.NewPos(40, 70)})
+	assert.NotEqual(t, 83.0, knob.Value())
+}
+
+func TestRotatingKnob_SetChangeLogger(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.Resize(fyne.NewSize(100, 100))
+
+	var values []float64
+	var sources []ChangeSource
+	knob.SetChangeLogger(func(at time.Time, value float64, source ChangeSource) {
+		assert.False(t, at.IsZero())
+		values = append(values, value)
+		sources = append(sources, source)
+	})
+
+	knob.SetValue(10)
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(50, 10)}})
+	knob.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, 1)})
+	knob.TypedKey(&fyne.KeyEvent{Name: fyne.KeyUp})
+	knob.Tapped(&fyne.PointEvent{Position: fyne.NewPos(90, 50)})
+
+	assert.Equal(t, []float64{10, 50, 51, 52, 83}, values)
+	assert.Equal(t, []ChangeSource{ChangeSourceSet, ChangeSourceDrag, ChangeSourceScroll, ChangeSourceKey, ChangeSourceTap}, sources)
+	assert.Equal(t, "drag", ChangeSourceDrag.String())
+
+	knob.SetChangeLogger(nil)
+	knob.SetValue(5)
+	assert.Len(t, values, 5)
+}
+
+func TestRotatingKnob_CompactThreshold(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	render := test.TempWidgetRenderer(t, knob).(*rotatingKnobRenderer)
+
+	// below the default threshold only the arcs and indicator remain
+	knob.Resize(fyne.NewSize(40, 40))
+	assert.False(t, render.dot.Visible())
+	assert.False(t, render.ticks[0].Visible())
+	assert.True(t, render.track.Visible())
+	assert.True(t, render.indicator.Visible())
+
+	knob.Resize(fyne.NewSize(100, 100))
+	assert.True(t, render.dot.Visible())
+	assert.True(t, render.ticks[0].Visible())
+
+	// a negative threshold never compacts
+	knob.CompactThreshold = -1
+	knob.Resize(fyne.NewSize(30, 30))
+	assert.True(t, render.dot.Visible())
+}
+
+func TestRotatingKnob_AutoRTL(t *testing.T) {
+	systemLocale = func() fyne.Locale { return "he" }
+	defer func() { systemLocale = lang.SystemLocale }()
+
+	knob := NewRotatingKnob(0, 100)
+	knob.AutoRTL = true
+	knob.Resize(fyne.NewSize(100, 100))
+
+	// in an RTL locale the mapping is mirrored, so Max sits at StartAngle
+	assert.Equal(t, knob.StartAngle, knob.angleForValue(100))
+	assert.Equal(t, knob.EndAngle, knob.angleForValue(0))
+
+	// a tap towards the right-hand side now lowers the value
+	knob.SetValue(50)
+	knob.Tapped(&fyne.PointEvent{Position: fyne.NewPos(90, 50)})
+	assert.Equal(t, 17.0, knob.Value())
+
+	// LTR locales are unaffected
+	systemLocale = func() fyne.Locale { return "en-US" }
+	assert.Equal(t, knob.EndAngle, knob.angleForValue(100))
+}
+
+func TestRotatingKnob_OnCenterTapped(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.Resize(fyne.NewSize(100, 100))
+
+	// without a callback a centre tap jumps the value as usual
+	knob.Tapped(&fyne.PointEvent{Position: fyne.NewPos(52, 55)})
+	assert.NotEqual(t, 0.0, knob.Value())
+
+	knob.SetValue(50)
+	taps := 0
+	knob.OnCenterTapped = func() {
+		taps++
+	}
+	knob.Tapped(&fyne.PointEvent{Position: fyne.NewPos(52, 55)})
+	assert.Equal(t, 1, taps)
+	assert.Equal(t, 50.0, knob.Value(), "centre tap does not move the value")
+
+	// taps on the ring still jump the value
+	knob.Tapped(&fyne.PointEvent{Position: fyne.NewPos(90, 50)})
+	assert.Equal(t, 1, taps)
+	assert.Equal(t, 83.0, knob.Value())
+}
+
+func TestRotatingKnob_TickLabels(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.TickCount = 4
+	knob.TickLabels = []string{"N", "E", "S", "W"}
+	knob.Resize(fyne.NewSize(100, 100))
+	render := test.TempWidgetRenderer(t, knob).(*rotatingKnobRenderer)
+
+	assert.Len(t, render.tickLabels, 4)
+	assert.Equal(t, "E", render.tickLabels[1].Text)
+	// the first label sits on the left half of the sweep, the last on the right
+	assert.Less(t, render.tickLabels[0].Position().X, float32(50))
+	assert.Greater(t, render.tickLabels[3].Position().X, float32(50))
+
+	// a slice shorter than TickCount draws no labels at all
+	knob.TickLabels = []string{"N", "E"}
+	knob.Refresh()
+	assert.Empty(t, render.tickLabels)
+}
+
+func TestRotatingKnob_Clone(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.Step = 5
+	knob.StartAngle = -90
+	knob.EndAngle = 90
+	knob.TickCount = 5
+	knob.Inverted = true
+	knob.OnChanged = func(_ float64) {}
+	knob.SetValue(40)
+
+	clone := knob.Clone()
+	assert.Equal(t, 0.0, clone.Min)
+	assert.Equal(t, 100.0, clone.Max)
+	assert.Equal(t, 5.0, clone.Step)
+	assert.Equal(t, float32(-90), clone.StartAngle)
+	assert.Equal(t, 5, clone.TickCount)
+	assert.True(t, clone.Inverted)
+
+	// callbacks and the current value are not carried over
+	assert.Nil(t, clone.OnChanged)
+	assert.Equal(t, 0.0, clone.Value())
+
+	// slices are copied, not shared
+	knob.Steps = []float64{0, 50, 100}
+	withSteps := knob.Clone()
+	withSteps.Steps[0] = 25
+	assert.Equal(t, 0.0, knob.Steps[0])
+}
+
+func TestRotatingKnob_Clone_CopiesAllExportedFields(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+
+	// give every exported declarative field a non-zero value, so that a field
+	// added to the struct but forgotten in Clone fails the comparison below
+	val := reflect.ValueOf(knob).Elem()
+	typ := val.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.Anonymous || !field.IsExported() || field.Tag.Get("json") == "-" {
+			continue // callbacks and bindings are deliberately not cloned
+		}
+		setCloneTestValue(t, field.Name, val.Field(i))
+	}
+
+	cloneVal := reflect.ValueOf(knob.Clone()).Elem()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.Anonymous || !field.IsExported() || field.Tag.Get("json") == "-" {
+			continue
+		}
+		assert.True(t, reflect.DeepEqual(val.Field(i).Interface(), cloneVal.Field(i).Interface()),
+			"Clone dropped the %s field", field.Name)
+	}
+}
+
+// setCloneTestValue writes a non-zero value of the appropriate type into the given
+// struct field, failing the test for field types it does not know how to populate.
+func setCloneTestValue(t *testing.T, name string, field reflect.Value) {
+	t.Helper()
+
+	colorType := reflect.TypeOf((*color.Color)(nil)).Elem()
+	resourceType := reflect.TypeOf((*fyne.Resource)(nil)).Elem()
+
+	switch field.Kind() {
+	case reflect.Bool:
+		field.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		field.SetInt(3)
+	case reflect.Float32, reflect.Float64:
+		field.SetFloat(7)
+	case reflect.String:
+		field.SetString("cloned")
+	case reflect.Slice:
+		elem := reflect.New(field.Type().Elem()).Elem()
+		setCloneTestValue(t, name, elem)
+		field.Set(reflect.Append(reflect.MakeSlice(field.Type(), 0, 1), elem))
+	case reflect.Array:
+		for i := 0; i < field.Len(); i++ {
+			setCloneTestValue(t, name, field.Index(i))
+		}
+	case reflect.Ptr:
+		elem := reflect.New(field.Type().Elem())
+		setCloneTestValue(t, name, elem.Elem())
+		field.Set(elem)
+	case reflect.Interface:
+		switch field.Type() {
+		case colorType:
+			field.Set(reflect.ValueOf(color.Color(color.NRGBA{R: 0xca, A: 0xff})))
+		case resourceType:
+			field.Set(reflect.ValueOf(fyne.Resource(theme.CancelIcon())))
+		default:
+			t.Fatalf("setCloneTestValue cannot populate interface field %s, teach it about %v", name, field.Type())
+		}
+	default:
+		t.Fatalf("setCloneTestValue cannot populate field %s, teach it about kind %v", name, field.Kind())
+	}
+}
+
+func TestRotatingKnob_OnValueFormatted(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+
+	var gotValue float64
+	var gotText string
+	knob.OnValueFormatted = func(value float64, text string) {
+		gotValue = value
+		gotText = text
+	}
+
+	// without a formatter the callback stays silent
+	knob.SetValue(10)
+	assert.Equal(t, "", gotText)
+
+	knob.ValueFormatter = func(value float64) string {
+		return fmt.Sprintf("%.0f%%", value)
+	}
+	knob.SetValue(25)
+	assert.Equal(t, 25.0, gotValue)
+	assert.Equal(t, "25%", gotText)
+}
+
+func TestRotatingKnob_Endless(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.Endless = true
+
+	// values accumulate past the bounds
+	knob.SetValue(150)
+	assert.Equal(t, 150.0, knob.Value())
+	knob.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, 1)})
+	assert.Equal(t, 151.0, knob.Value())
+	knob.SetValue(-30)
+	assert.Equal(t, -30.0, knob.Value())
+
+	// the indicator shows the position modulo the sweep
+	assert.Equal(t, knob.angleForValue(50), knob.angleForValue(150))
+	assert.Equal(t, knob.angleForValue(70), knob.angleForValue(-30))
+
+	// drags take the shortest way around the wrap
+	knob.SetValue(95)
+	assert.Equal(t, 10.0, knob.endlessDelta(5))
+	assert.Equal(t, -10.0, knob.endlessDelta(85))
+}
+
+// assertKnobColors checks every visual element of the knob against the palette
+// expected for its disabled state, so new elements cannot forget the disabled branch.
+func assertKnobColors(t *testing.T, render *rotatingKnobRenderer, disabled bool) {
+	t.Helper()
+	th := theme.Current()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+
+	foreground := th.Color(theme.ColorNameForeground, v)
+	track := th.Color(theme.ColorNameInputBackground, v)
+	tick := th.Color(theme.ColorNameShadow, v)
+	if disabled {
+		foreground = th.Color(theme.ColorNameDisabled, v)
+		track = th.Color(theme.ColorNameDisabledButton, v)
+		tick = foreground
+	}
+
+	assert.Equal(t, track, render.track.FillColor)
+	assert.Equal(t, foreground, render.active.FillColor)
+	assert.Equal(t, foreground, render.dot.FillColor)
+	assert.Equal(t, foreground, render.indicator.StrokeColor)
+	for _, mark := range render.ticks {
+		assert.Equal(t, tick, mark.StrokeColor)
+	}
+}
+
+func TestRotatingKnob_DisabledColors(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	render := test.TempWidgetRenderer(t, knob).(*rotatingKnobRenderer)
+	assertKnobColors(t, render, false)
+
+	knob.Disable()
+	assertKnobColors(t, render, true)
+
+	// enabling flips every element back to the regular palette
+	knob.Enable()
+	assertKnobColors(t, render, false)
+}
+
+func TestRotatingKnob_LongPressFineMode(t *testing.T) {
+	knobOnMobile = func() bool { return true }
+	defer func() { knobOnMobile = func() bool { return fyne.CurrentDevice().IsMobile() } }()
+
+	knob := NewRotatingKnob(0, 100)
+	knob.Resize(fyne.NewSize(100, 100))
+	knob.SetValue(50)
+
+	// a press held past the delay makes the following drag a fine adjustment
+	knob.TouchDown(&mobile.TouchEvent{})
+	knob.touchTime = knob.touchTime.Add(-knobFinePressDelay)
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(90, 50)}})
+	assert.True(t, knob.fineMode)
+	assert.Equal(t, 53.0, knob.Value(), "a tenth of the way towards the pointer")
+
+	knob.DragEnd()
+	assert.False(t, knob.fineMode)
+
+	// a quick press keeps the usual coarse tracking
+	knob.TouchDown(&mobile.TouchEvent{})
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(90, 50)}})
+	assert.False(t, knob.fineMode)
+	assert.Equal(t, 83.0, knob.Value())
+	knob.DragEnd()
+	knob.TouchUp(&mobile.TouchEvent{})
+	assert.True(t, knob.touchTime.IsZero())
+}
+
+func TestRotatingKnob_TouchOffsetY(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.TouchOffsetY = 40
+	knob.Resize(fyne.NewSize(100, 100))
+	knob.SetValue(50)
+
+	// on desktop the offset is ignored and the pointer maps directly
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(90, 50)}})
+	assert.Equal(t, 83.0, knob.Value())
+	knob.DragEnd()
+
+	knobOnMobile = func() bool { return true }
+	defer func() { knobOnMobile = func() bool { return fyne.CurrentDevice().IsMobile() } }()
+
+	// on mobile the finger at (90, 90) tracks as if it were at (90, 50)
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(90, 90)}})
+	assert.Equal(t, 83.0, knob.Value())
+	knob.DragEnd()
+}
+
+func TestRotatingKnob_Rounding(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.Step = 2
+
+	// the default mode rounds halves towards Min
+	knob.SetValue(3)
+	assert.Equal(t, 2.0, knob.Value())
+
+	knob.Rounding = RoundHalfUp
+	knob.SetValue(3)
+	assert.Equal(t, 4.0, knob.Value())
+	knob.SetValue(5)
+	assert.Equal(t, 6.0, knob.Value())
+
+	// banker's rounding sends halves to the even step multiple
+	knob.Rounding = RoundHalfEven
+	knob.SetValue(3)
+	assert.Equal(t, 4.0, knob.Value())
+	knob.SetValue(5)
+	assert.Equal(t, 4.0, knob.Value())
+}
+
+func TestRotatingKnob_Geometry(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.Resize(fyne.NewSize(100, 100))
+	render := test.TempWidgetRenderer(t, knob).(*rotatingKnobRenderer)
+
+	center, radius := knob.Geometry()
+	assert.Equal(t, fyne.NewPos(50, 50), center)
+	// the radius matches the arc the renderer laid out
+	assert.Equal(t, radius*2, render.track.Size().Width)
+
+	knob.Resize(fyne.NewSize(120, 80))
+	center, radius = knob.Geometry()
+	assert.Equal(t, fyne.NewPos(60, 40), center)
+	assert.Equal(t, radius*2, render.track.Size().Width)
+}
+
+func TestRotatingKnob_MinDiameter(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	base := knob.MinSize()
+
+	knob.MinDiameter = base.Width + 36
+	assert.Equal(t, fyne.NewSquareSize(base.Width+36), knob.MinSize())
+
+	// values below the theme default, including negatives, are ignored
+	knob.MinDiameter = 10
+	assert.Equal(t, base, knob.MinSize())
+	knob.MinDiameter = -5
+	assert.Equal(t, base, knob.MinSize())
+
+	control := NewRotaryControl(0, 100)
+	control.MinDiameter = base.Width + 36
+	assert.Equal(t, fyne.NewSquareSize(base.Width+36), control.MinSize())
+}
+
+func TestRotatingKnob_TickColor(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.TickColor = color.NRGBA{R: 0xff, A: 0xff}
+	render := test.TempWidgetRenderer(t, knob).(*rotatingKnobRenderer)
+
+	assert.Equal(t, knob.TickColor, render.ticks[0].StrokeColor)
+
+	// the disabled palette still wins over a custom tick colour
+	knob.Disable()
+	th := theme.Current()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+	assert.Equal(t, th.Color(theme.ColorNameDisabled, v), render.ticks[0].StrokeColor)
+
+	// clearing the override returns to the theme default
+	knob.Enable()
+	knob.TickColor = nil
+	knob.Refresh()
+	assert.Equal(t, th.Color(theme.ColorNameShadow, v), render.ticks[0].StrokeColor)
+}
+
+func TestRotatingKnob_Bind(t *testing.T) {
+	data := binding.NewFloat()
+	knob := NewRotatingKnobWithData(0, 100, data)
+
+	writes := 0
+	data.AddListener(binding.NewDataListener(func() {
+		writes++
+	}))
+	writes = 0 // ignore the initial sync
+
+	// an external Set produces exactly one knob update and no write-back
+	assert.NoError(t, data.Set(30))
+	assert.Equal(t, 30.0, knob.Value())
+	assert.Equal(t, 1, writes)
+
+	// user interaction still writes through to the data
+	knob.SetValue(60)
+	v, err := data.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, 60.0, v)
+
+	knob.Unbind()
+	knob.SetValue(10)
+	v, _ = data.Get()
+	assert.Equal(t, 60.0, v)
+}
+
+func TestRotatingKnob_Wedge(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.WedgeColor = color.NRGBA{R: 0xff, A: 0xff}
+	knob.Resize(fyne.NewSize(100, 100))
+	knob.SetValue(50)
+
+	r := test.TempWidgetRenderer(t, knob).(*rotatingKnobRenderer)
+	assert.Len(t, r.wedge, 1)
+	assert.Equal(t, knob.WedgeColor, r.wedge[0].FillColor)
+	assert.Equal(t, r.wedge[0], r.Objects()[0]) // beneath the track
+	start, _ := knob.angleRange()
+	assert.Equal(t, start, r.wedge[0].StartAngle)
+	assert.Equal(t, knob.angleForValue(50), r.wedge[0].EndAngle)
+
+	knob.WedgeGradient = [2]color.Color{color.Black, color.White}
+	knob.Refresh()
+	assert.Len(t, r.wedge, knobWedgeSegments)
+	first, _, _, _ := r.wedge[0].FillColor.RGBA()
+	last, _, _, _ := r.wedge[knobWedgeSegments-1].FillColor.RGBA()
+	assert.Less(t, first, last) // fades towards the current angle
+	assert.Equal(t, knob.angleForValue(50), r.wedge[knobWedgeSegments-1].EndAngle)
+}
+
+func TestRotatingKnob_ShowGap(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.StartAngle = -135
+	knob.EndAngle = 135
+	knob.Resize(fyne.NewSize(100, 100))
+
+	r := test.TempWidgetRenderer(t, knob).(*rotatingKnobRenderer)
+	assert.False(t, r.gap.Visible())
+
+	knob.ShowGap = true
+	knob.Refresh()
+	assert.True(t, r.gap.Visible())
+	assert.Equal(t, float32(135), r.gap.StartAngle)
+	assert.Equal(t, float32(225), r.gap.EndAngle)
+	_, _, _, alpha := r.gap.FillColor.RGBA()
+	assert.Less(t, alpha, uint32(0x4000)) // faint by default
+
+	knob.GapColor = color.NRGBA{R: 0xff, A: 0xff}
+	knob.Refresh()
+	assert.Equal(t, knob.GapColor, r.gap.FillColor)
+
+	// a full-circle knob has no gap to show
+	knob.StartAngle = 0
+	knob.EndAngle = 360
+	knob.Refresh()
+	assert.False(t, r.gap.Visible())
+}
+
+func TestRotatingKnob_ScrolledWrapping(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.Wrapping = true
+	knob.SetValue(100)
+
+	knob.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, 1)})
+	assert.Equal(t, 1.0, knob.Value(), "scrolling past Max should wrap around to Min")
+
+	knob.SetValue(0)
+	knob.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, -1)})
+	assert.Equal(t, 99.0, knob.Value(), "scrolling past Min should wrap around to Max")
+
+	// without Wrapping the value clamps at the ends
+	knob.Wrapping = false
+	knob.SetValue(100)
+	knob.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, 1)})
+	assert.Equal(t, 100.0, knob.Value())
+}
+
+func TestRotatingKnob_Percent(t *testing.T) {
+	knob := NewRotatingKnob(50, 150)
+	knob.SetValue(75)
+	assert.Equal(t, 25.0, knob.Percent())
+
+	knob.SetPercent(50)
+	assert.Equal(t, 100.0, knob.Value())
+	knob.SetPercent(120)
+	assert.Equal(t, 150.0, knob.Value(), "percentages clamp like SetValue")
+
+	empty := NewRotatingKnob(10, 10)
+	assert.Equal(t, 0.0, empty.Percent())
+}
+
+func TestRotatingKnob_ShiftArrowFineStep(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.SetValue(50)
+
+	knob.TypedKey(&fyne.KeyEvent{Name: fyne.KeyUp})
+	assert.Equal(t, 51.0, knob.Value())
+
+	knob.KeyDown(&fyne.KeyEvent{Name: desktop.KeyShiftLeft})
+	knob.TypedKey(&fyne.KeyEvent{Name: fyne.KeyUp})
+	assert.InDelta(t, 51.1, knob.Value(), 1e-9, "shifted arrows move by a tenth of Step")
+	knob.KeyUp(&fyne.KeyEvent{Name: desktop.KeyShiftLeft})
+
+	knob.TypedKey(&fyne.KeyEvent{Name: fyne.KeyDown})
+	assert.Equal(t, 50.0, knob.Value(), "releasing shift restores coarse stepping and snapping")
+	assert.Equal(t, 1.0, knob.Step, "the configured step is left untouched")
+}
+
+func TestRotatingKnob_EffectiveStep(t *testing.T) {
+	knob := NewRotatingKnob(0, 200)
+	assert.Equal(t, 1.0, knob.EffectiveStep())
+
+	knob.Step = 0
+	assert.Equal(t, 2.0, knob.EffectiveStep(), "zero Step defaults to one percent of the range")
+
+	// keyboard and scroll share the default
+	knob.TypedKey(&fyne.KeyEvent{Name: fyne.KeyUp})
+	assert.Equal(t, 2.0, knob.Value())
+	knob.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, 1)})
+	assert.Equal(t, 4.0, knob.Value())
+}
+
+func TestRotatingKnob_SpringReturn(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.SpringReturn = true
+	knob.SpringTarget = 20
+	knob.Resize(fyne.NewSize(100, 100))
+
+	ended := []float64{}
+	knob.OnChangeEnded = func(value float64) {
+		ended = append(ended, value)
+	}
+
+	// the test driver runs animations to completion immediately
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(90, 50)}})
+	assert.Equal(t, 83.0, knob.Value())
+	knob.DragEnd()
+	assert.Equal(t, 20.0, knob.Value(), "releasing returns the value to the target")
+	assert.Equal(t, []float64{83, 20}, ended, "OnChangeEnded fires once at rest")
+
+	changes := 0
+	knob.OnChanged = func(_ float64) {
+		changes++
+	}
+	knob.springFrame(83, 0.5)
+	assert.Equal(t, 51.0, knob.Value(), "the value eases towards the target, snapping to Step")
+	assert.Equal(t, 1, changes, "OnChanged fires during the return")
+
+	// interacting again cancels a return that is under way
+	knob.springAnim = fyne.NewAnimation(time.Second, func(_ float32) {})
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(90, 50)}})
+	assert.Nil(t, knob.springAnim)
+	knob.DragEnd()
+}
+
+func TestRotatingKnob_OnReset(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.DefaultValue = 10
+	knob.SetValue(50)
+
+	resets := 0
+	knob.OnReset = func() {
+		resets++
+	}
+
+	knob.DoubleTapped(&fyne.PointEvent{})
+	assert.Equal(t, 10.0, knob.Value())
+	assert.Equal(t, 1, resets)
+
+	// ordinary changes never report a reset
+	knob.SetValue(10)
+	knob.SetValue(60)
+	assert.Equal(t, 1, resets)
+
+	knob.Disable()
+	knob.DoubleTapped(&fyne.PointEvent{})
+	assert.Equal(t, 60.0, knob.Value())
+	assert.Equal(t, 1, resets)
+}
+
+func TestRotatingKnob_Resettable(t *testing.T) {
+	knob := NewRotatingKnob(20, 100)
+	knob.SetValue(90)
+
+	control := NewRotaryControl(20, 100)
+	control.DefaultValue = 25
+	control.SetValue(90)
+
+	// a generic reset-all loop needs no type switches
+	for _, r := range []Resettable{knob, control} {
+		r.Reset()
+	}
+	assert.Equal(t, 60.0, knob.Value(), "an unset default outside the range resets to the midpoint")
+	assert.Equal(t, 25.0, control.Value())
+}
+
+func TestRotatingKnob_DisabledColor(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	render := test.TempWidgetRenderer(t, knob).(*rotatingKnobRenderer)
+
+	locked := color.NRGBA{R: 0xff, A: 0xff}
+	knob.DisabledColor = locked
+	knob.Refresh()
+	enabled := render.active.FillColor
+
+	knob.Disable()
+	assert.Equal(t, locked, render.active.FillColor)
+	assert.Equal(t, locked, render.dot.FillColor)
+	assert.Equal(t, locked, render.ticks[0].StrokeColor)
+
+	// nil keeps the theme's disabled palette
+	knob.DisabledColor = nil
+	knob.Refresh()
+	assert.Equal(t, theme.Color(theme.ColorNameDisabled), render.active.FillColor)
+
+	knob.Enable()
+	assert.Equal(t, enabled, render.active.FillColor)
+}
+
+func TestRotatingKnob_OnChangeEndedSource(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.Resize(fyne.NewSize(100, 100))
+
+	sources := []ChangeSource{}
+	knob.OnChangeEndedSource = func(_ float64, source ChangeSource) {
+		sources = append(sources, source)
+	}
+
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(90, 50)}})
+	knob.DragEnd()
+	knob.TypedKey(&fyne.KeyEvent{Name: fyne.KeyUp})
+	knob.Tapped(&fyne.PointEvent{Position: fyne.NewPos(50, 10)})
+
+	assert.Equal(t, []ChangeSource{ChangeSourceDrag, ChangeSourceKey, ChangeSourceTap}, sources)
+}
+
+func TestRotatingKnob_InvertScroll(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.SetValue(50)
+	knob.InvertScroll = true
+
+	knob.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, 1)})
+	assert.Equal(t, 49.0, knob.Value(), "scrolling up decreases when inverted")
+
+	knob.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, -1)})
+	assert.Equal(t, 50.0, knob.Value())
+
+	knob.InvertScroll = false
+	knob.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, 1)})
+	assert.Equal(t, 51.0, knob.Value())
+}
+
+func TestRotatingKnob_MultiKnobLayout(t *testing.T) {
+	// mirror the tutorial screen: eight differently configured knobs in one grid
+	basic := NewRotatingKnob(0, 100)
+	stepped := NewRotatingKnob(0, 100)
+	stepped.Steps = []float64{0, 25, 50, 75, 100}
+	stepped.StepLabels = []string{"0", "25", "50", "75", "100"}
+	ticked := NewRotatingKnob(0, 12)
+	ticked.TickCount = 13
+	inverted := NewRotatingKnob(0, 100)
+	inverted.Inverted = true
+	endless := NewRotatingKnob(0, 360)
+	endless.Endless = true
+	gapped := NewRotatingKnob(0, 100)
+	gapped.ShowGap = true
+	wedged := NewRotatingKnob(0, 100)
+	wedged.WedgeGradient = [2]color.Color{color.Black, color.White}
+	disabled := NewRotatingKnob(0, 100)
+	disabled.Disable()
+
+	knobs := []*RotatingKnob{basic, stepped, ticked, inverted, endless, gapped, wedged, disabled}
+	grid := &fyne.Container{Layout: layout.NewGridLayout(4)}
+	for _, knob := range knobs {
+		knob.SetValue(30)
+		grid.Add(knob)
+	}
+
+	w := test.NewTempWindow(t, grid)
+	w.Resize(fyne.NewSize(640, 400))
+
+	for i, knob := range knobs {
+		render := test.TempWidgetRenderer(t, knob)
+		assert.NotEmpty(t, render.Objects(), "knob %d should render objects", i)
+		assert.Positive(t, knob.Size().Width, "knob %d should be laid out", i)
+	}
+}
+
+func TestRotatingKnob_FocusDisabled(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.Disable()
+	w := test.NewTempWindow(t, knob)
+
+	w.Canvas().Focus(knob)
+	assert.Nil(t, w.Canvas().Focused(), "a disabled knob should not become the focus target")
+	assert.False(t, knob.focused)
+
+	// even a direct focus callback leaves the knob unfocused
+	knob.FocusGained()
+	assert.False(t, knob.focused)
+
+	knob.Enable()
+	w.Canvas().Focus(knob)
+	assert.Equal(t, knob, w.Canvas().Focused())
+	assert.True(t, knob.focused)
+}
+
+func TestNewRotatingKnobWithGap(t *testing.T) {
+	knob := NewRotatingKnobWithGap(0, 100, 90)
+	assert.Equal(t, float32(-135), knob.StartAngle)
+	assert.Equal(t, float32(135), knob.EndAngle)
+
+	full := NewRotatingKnobWithGap(0, 100, 0)
+	assert.Equal(t, float32(-180), full.StartAngle)
+	assert.Equal(t, float32(180), full.EndAngle)
+
+	clamped := NewRotatingKnobWithGap(0, 100, 500)
+	assert.Equal(t, float32(0), clamped.StartAngle)
+	assert.Equal(t, float32(0), clamped.EndAngle)
+}
+
+func TestRotatingKnob_ValueColorFunc(t *testing.T) {
+	cold := color.NRGBA{B: 0xff, A: 0xff}
+	hot := color.NRGBA{R: 0xff, A: 0xff}
+
+	knob := NewRotatingKnob(0, 100)
+	knob.ValueColorFunc = func(value float64) color.Color {
+		if value >= 50 {
+			return hot
+		}
+		return cold
+	}
+	render := test.TempWidgetRenderer(t, knob).(*rotatingKnobRenderer)
+
+	knob.SetValue(25)
+	assert.Equal(t, cold, render.active.FillColor)
+	assert.Equal(t, cold, render.indicator.StrokeColor)
+
+	knob.SetValue(75)
+	assert.Equal(t, hot, render.active.FillColor)
+	assert.Equal(t, hot, render.dot.FillColor)
+
+	// the disabled palette always wins
+	knob.Disable()
+	assert.Equal(t, theme.Color(theme.ColorNameDisabled), render.active.FillColor)
+}
+
+func TestRotatingKnob_HoverAnimation(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	assert.True(t, knob.HoverAnimation, "animation is on by default")
+	render := test.TempWidgetRenderer(t, knob).(*rotatingKnobRenderer)
+	assert.Nil(t, render.active.GlowColor)
+
+	// the test driver runs animations to completion immediately
+	knob.MouseIn(&desktop.MouseEvent{})
+	assert.NotNil(t, render.active.GlowColor)
+	knob.MouseOut()
+	assert.Nil(t, render.active.GlowColor)
+
+	// disabling the animation jumps straight between states
+	knob.HoverAnimation = false
+	knob.MouseIn(&desktop.MouseEvent{})
+	assert.Nil(t, knob.hoverAnim)
+	assert.NotNil(t, render.active.GlowColor)
+	knob.MouseOut()
+	assert.Nil(t, render.active.GlowColor)
+}
+
+func TestRotatingKnob_DisableAnimations(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.DisableAnimations = true
+	knob.SpringReturn = true
+	knob.SpringTarget = 0
+	knob.Resize(fyne.NewSize(100, 100))
+	render := test.TempWidgetRenderer(t, knob).(*rotatingKnobRenderer)
+
+	// the hover glow jumps straight in and out without an animation
+	knob.MouseIn(&desktop.MouseEvent{})
+	assert.Nil(t, knob.hoverAnim)
+	assert.NotNil(t, render.active.GlowColor)
+	knob.MouseOut()
+	assert.Nil(t, render.active.GlowColor)
+
+	// the spring return rests instantly instead of animating
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(90, 50)}})
+	knob.DragEnd()
+	assert.Nil(t, knob.springAnim)
+	assert.Equal(t, 0.0, knob.Value())
+}
+
+func TestRotatingKnob_Pulse(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.Resize(fyne.NewSize(100, 100))
+	render := test.TempWidgetRenderer(t, knob).(*rotatingKnobRenderer)
+
+	// the test driver ticks straight to the end of a cycle
+	knob.Pulse(1)
+	assert.Nil(t, knob.pulseAnim, "the glow returns to normal after the last pulse")
+	assert.InDelta(t, 0, knob.glowFrac, 0.0001)
+	assert.Nil(t, render.active.GlowColor)
+
+	// interacting cancels a pulse that is still running
+	knob.Pulse(2) // the test driver completes only the first cycle
+	assert.NotNil(t, knob.pulseAnim)
+	knob.Tapped(&fyne.PointEvent{Position: fyne.NewPos(50, 10)})
+	assert.Nil(t, knob.pulseAnim)
+	assert.Equal(t, float32(0), knob.glowFrac)
+
+	// with animations off the pulse is skipped entirely
+	knob.DisableAnimations = true
+	knob.Pulse(3)
+	assert.Nil(t, knob.pulseAnim)
+}
+
+func TestRotatingKnob_CopyPasteShortcuts(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.Step = 0.5
+	knob.SetValue(42)
+	clipboard := test.NewClipboard()
+
+	knob.TypedShortcut(&fyne.ShortcutCopy{Clipboard: clipboard})
+	assert.Equal(t, "42", clipboard.Content())
+
+	clipboard.SetContent(" 73.5 ")
+	knob.TypedShortcut(&fyne.ShortcutPaste{Clipboard: clipboard})
+	assert.Equal(t, 73.5, knob.Value())
+
+	// content that is not a number leaves the value alone
+	clipboard.SetContent("loud")
+	knob.TypedShortcut(&fyne.ShortcutPaste{Clipboard: clipboard})
+	assert.Equal(t, 73.5, knob.Value())
+
+	// the formatter shapes what lands on the clipboard
+	knob.ValueFormatter = func(v float64) string { return fmt.Sprintf("%.1f dB", v) }
+	knob.TypedShortcut(&fyne.ShortcutCopy{Clipboard: clipboard})
+	assert.Equal(t, "73.5 dB", clipboard.Content())
+
+	// disabled knobs still copy but ignore paste
+	knob.Disable()
+	clipboard.SetContent("10")
+	knob.TypedShortcut(&fyne.ShortcutPaste{Clipboard: clipboard})
+	assert.Equal(t, 73.5, knob.Value())
+}
+
+func TestRotatingKnob_EscapeCancelsDrag(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.SetValue(40)
+	knob.Resize(fyne.NewSize(100, 100))
+
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(90, 50)}})
+	assert.Equal(t, 83.0, knob.Value())
+
+	knob.TypedKey(&fyne.KeyEvent{Name: fyne.KeyEscape})
+	assert.Equal(t, 40.0, knob.Value(), "escape reverts to the pre-drag value")
+	assert.False(t, knob.isDragging)
+
+	// escape outside a drag changes nothing
+	knob.TypedKey(&fyne.KeyEvent{Name: fyne.KeyEscape})
+	assert.Equal(t, 40.0, knob.Value())
+}
+
+func TestRotatingKnob_MouseDownFocuses(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	w := test.NewTempWindow(t, knob)
+
+	knob.MouseDown(&desktop.MouseEvent{})
+	assert.Equal(t, knob, w.Canvas().Focused(), "pressing the knob focuses it for keyboard cancel")
+}
+
+func TestRotatingKnob_IsDragging(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.Resize(fyne.NewSize(100, 100))
+	assert.False(t, knob.IsDragging())
+
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(90, 50)}})
+	assert.True(t, knob.IsDragging())
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(50, 10)}})
+	assert.True(t, knob.IsDragging())
+
+	knob.DragEnd()
+	assert.False(t, knob.IsDragging())
+}
+
+func TestRotatingKnob_RenderGolden(t *testing.T) {
+	test.ApplyTheme(t, test.Theme())
+
+	knob := NewRotatingKnob(0, 100)
+	knob.TickCount = 5
+	knob.SetValue(65)
+
+	w := test.NewTempWindow(t, knob)
+	w.Resize(fyne.NewSize(140, 140))
+
+	// pixel comparison catches arc rasterization regressions (cap, cutout, tick
+	// placement) that markup assertions cannot see
+	test.AssertImageMatches(t, "rotatingknob/default.png", w.Canvas().Capture())
+}
+
+func TestRotatingKnob_ResetShortcut(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.DefaultValue = 25
+	knob.SetValue(80)
+
+	knob.TypedKey(&fyne.KeyEvent{Name: fyne.KeyR})
+	assert.Equal(t, 80.0, knob.Value(), "no shortcut is configured by default")
+
+	knob.ResetShortcut = fyne.KeyR
+	resets := 0
+	knob.OnReset = func() { resets++ }
+	knob.TypedKey(&fyne.KeyEvent{Name: fyne.KeyR})
+	assert.Equal(t, 25.0, knob.Value())
+	assert.Equal(t, 1, resets)
+
+	knob.Disable()
+	knob.SetValue(80)
+	knob.TypedKey(&fyne.KeyEvent{Name: fyne.KeyR})
+	assert.Equal(t, 80.0, knob.Value(), "disabled knobs ignore the shortcut")
+}
+
+func TestRotatingKnob_CenterIcon(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	render := test.TempWidgetRenderer(t, knob).(*rotatingKnobRenderer)
+	knob.Resize(fyne.NewSize(120, 120))
+
+	assert.False(t, render.centerIcon.Visible(), "nil icon leaves the plain dot")
+
+	knob.CenterIcon = theme.MediaPlayIcon()
+	knob.Refresh()
+	assert.True(t, render.centerIcon.Visible())
+	assert.Equal(t, theme.MediaPlayIcon(), render.centerIcon.Resource)
+	assert.Equal(t, render.dot.Size(), render.centerIcon.Size(), "icon scales to the dot")
+	assert.Equal(t, render.dot.Position(), render.centerIcon.Position())
+
+	knob.CenterIcon = nil
+	knob.Refresh()
+	assert.False(t, render.centerIcon.Visible())
+}
+
+func TestRotatingKnob_FocusRingModality(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	render := test.TempWidgetRenderer(t, knob).(*rotatingKnobRenderer)
+
+	knob.FocusGained()
+	assert.NotNil(t, render.active.GlowColor, "tab or programmatic focus shows the ring")
+
+	knob.FocusLost()
+	assert.Nil(t, render.active.GlowColor)
+
+	knob.MouseDown(&desktop.MouseEvent{})
+	knob.FocusGained()
+	assert.Nil(t, render.active.GlowColor, "focus the pointer just placed needs no ring")
+
+	knob.TypedKey(&fyne.KeyEvent{Name: fyne.KeyUp})
+	assert.NotNil(t, render.active.GlowColor, "keyboard use while focused reveals the ring")
+}
+
+func TestRotatingKnob_Nudge(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	changes, ends := 0, 0
+	knob.OnChanged = func(float64) { changes++ }
+	knob.OnChangeEnded = func(float64) { ends++ }
+
+	knob.Nudge(5)
+	assert.Equal(t, 5.0, knob.Value())
+	assert.Equal(t, 1, changes)
+	assert.Equal(t, 1, ends)
+
+	knob.Nudge(-2)
+	assert.Equal(t, 3.0, knob.Value())
+
+	knob.Nudge(200)
+	assert.Equal(t, 100.0, knob.Value(), "nudges clamp at the range ends")
+
+	knob.Wrapping = true
+	knob.Nudge(1)
+	assert.Equal(t, 1.0, knob.Value(), "wrapping knobs wrap past Max")
+
+	knob.Disable()
+	knob.Nudge(1)
+	assert.Equal(t, 1.0, knob.Value(), "disabled knobs ignore nudges")
+}
+
+func TestRotatingKnob_NudgeSteps(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.Steps = []float64{0, 10, 50, 100}
+
+	knob.Nudge(2)
+	assert.Equal(t, 50.0, knob.Value(), "discrete nudges move through adjacent entries")
+	knob.Nudge(-1)
+	assert.Equal(t, 10.0, knob.Value())
+	knob.Nudge(10)
+	assert.Equal(t, 100.0, knob.Value(), "discrete nudges stop at the last entry")
+}
+
+func TestRotatingKnob_RelativeDrag(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	assert.True(t, knob.AbsoluteDrag, "absolute dragging stays the default")
+	knob.AbsoluteDrag = false
+	knob.Resize(fyne.NewSize(100, 100))
+	knob.SetValue(50)
+
+	// grab at the right of the dial, well away from the indicator - no jump
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(90, 50)}})
+	assert.Equal(t, 50.0, knob.Value(), "grabbing off-thumb must not move the value")
+
+	// moving the pointer to the top applies the same angular delta to the value
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(50, 10)}})
+	assert.InDelta(t, 17.0, knob.Value(), 1.0, "the drag applies its delta from the grab point")
+	knob.DragEnd()
+}
+
+func TestRotatingKnob_StrokeScalesWithSize(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	render := test.TempWidgetRenderer(t, knob).(*rotatingKnobRenderer)
+
+	thickness := func() float64 {
+		_, radius := knob.Geometry()
+		return float64(radius * (1 - render.track.CutoutRatio))
+	}
+
+	knob.Resize(fyne.NewSize(50, 50))
+	render.Refresh()
+	assert.InDelta(t, 4, thickness(), 0.01, "small knobs clamp to the minimum stroke")
+
+	knob.Resize(fyne.NewSize(100, 100))
+	render.Refresh()
+	_, radius := knob.Geometry()
+	assert.InDelta(t, float64(radius)*2*0.08, thickness(), 0.01, "mid sizes scale with the diameter")
+
+	knob.Resize(fyne.NewSize(400, 400))
+	render.Refresh()
+	assert.InDelta(t, 16, thickness(), 0.01, "large knobs clamp to the maximum stroke")
+
+	knob.TrackThicknessRatio = 0.3
+	render.Refresh()
+	_, radius = knob.Geometry()
+	assert.InDelta(t, float64(radius)*0.3, thickness(), 0.01, "an explicit ratio overrides the scaling")
+}
+
+func TestRotatingKnob_HighContrast(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.Resize(fyne.NewSize(100, 100))
+	render := test.TempWidgetRenderer(t, knob).(*rotatingKnobRenderer)
+	render.Refresh()
+
+	thickness := func() float32 {
+		_, radius := knob.Geometry()
+		return radius * (1 - render.track.CutoutRatio)
+	}
+	normalStroke := thickness()
+	normalIndicator := render.indicator.StrokeWidth
+
+	knob.HighContrast = true
+	render.Refresh()
+	assert.Equal(t, theme.AccessiblePalette.Foreground, render.active.FillColor)
+	assert.Equal(t, theme.AccessiblePalette.Background, render.track.FillColor)
+	assert.InDelta(t, float64(normalStroke)*1.5, float64(thickness()), 0.01, "the track stroke widens")
+	assert.InDelta(t, float64(normalIndicator)*1.5, float64(render.indicator.StrokeWidth), 0.01)
+
+	// the focus glow uses the accessible focus colour and widens too
+	knob.FocusGained()
+	render.Refresh()
+	assert.Equal(t, theme.AccessiblePalette.Focus, render.active.GlowColor)
+}
+
+func TestRotatingKnob_HybridDrag(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.HybridDrag = true
+	knob.Resize(fyne.NewSize(100, 100))
+	knob.SetValue(50)
+
+	// a gesture starting on the outer ring sweeps the value to the pointer's angle
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(90, 50)}})
+	assert.InDelta(t, 83.0, knob.Value(), 1.0, "ring drags keep the coarse absolute behaviour")
+	knob.DragEnd()
+
+	// a gesture starting in the centre nudges the value with vertical movement
+	knob.SetValue(50)
+	knob.Dragged(&fyne.DragEvent{
+		PointEvent: fyne.PointEvent{Position: fyne.NewPos(50, 25)},
+		Dragged:    fyne.Delta{DY: -25},
+	})
+	assert.InDelta(t, 55.0, knob.Value(), 0.5, "centre drags make fine vertical adjustments")
+
+	// the mode is fixed per gesture, so leaving the centre does not switch back
+	knob.Dragged(&fyne.DragEvent{
+		PointEvent: fyne.PointEvent{Position: fyne.NewPos(50, 10)},
+		Dragged:    fyne.Delta{DY: -15},
+	})
+	assert.InDelta(t, 58.0, knob.Value(), 0.5, "the gesture stays fine after leaving the centre")
+	knob.DragEnd()
+
+	// widening the threshold turns the same ring grab into a fine gesture
+	knob.HybridThreshold = 1
+	knob.SetValue(50)
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(90, 50)}})
+	assert.Equal(t, 50.0, knob.Value(), "inside the threshold only vertical movement counts")
+	knob.DragEnd()
+}
+
+func TestRotatingKnob_MinChangeDelta(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+
+	var notified []float64
+	knob.OnChanged = func(v float64) { notified = append(notified, v) }
+	final := 0.0
+	knob.OnChangeEnded = func(v float64) { final = v }
+
+	knob.MinChangeDelta = 10
+	knob.Resize(fyne.NewSize(100, 100))
+
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(50, 10)}})
+	assert.Equal(t, []float64{50}, notified)
+
+	// a small movement is withheld but still moves and redraws the knob
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(52, 10)}})
+	assert.Equal(t, []float64{50}, notified, "deltas measure from the last notified value")
+	assert.Equal(t, 51.0, knob.Value(), "the value itself still updates")
+
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(90, 50)}})
+	assert.Equal(t, []float64{50, 83}, notified)
+
+	knob.DragEnd()
+	assert.Equal(t, knob.Value(), final, "the gesture end always reports the final value")
+}
+
+func TestRotatingKnob_ThumbPosition(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.Resize(fyne.NewSize(100, 100))
+	render := test.TempWidgetRenderer(t, knob).(*rotatingKnobRenderer)
+
+	knob.SetValue(50)
+	thumb := knob.ThumbPosition()
+	assert.InDelta(t, 50, thumb.X, 0.001, "the middle of the sweep points straight up")
+	assert.Less(t, thumb.Y, float32(50))
+
+	// the thumb sits exactly at the indicator's outer end
+	assert.InDelta(t, render.indicator.Position2.X, thumb.X, 0.001)
+	assert.InDelta(t, render.indicator.Position2.Y, thumb.Y, 0.001)
+
+	knob.SetValue(100)
+	assert.InDelta(t, render.indicator.Position2.X, knob.ThumbPosition().X, 0.001)
+	assert.InDelta(t, render.indicator.Position2.Y, knob.ThumbPosition().Y, 0.001)
+}
+
+func TestRotatingKnob_DisabledIgnoresAllHandlers(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.Resize(fyne.NewSize(100, 100))
+	knob.DefaultValue = 10
+	knob.SetValue(40)
+	knob.Disable()
+
+	secondary := false
+	knob.OnTappedSecondary = func(*fyne.PointEvent) { secondary = true }
+
+	knob.Tapped(&fyne.PointEvent{Position: fyne.NewPos(90, 50)})
+	knob.DoubleTapped(&fyne.PointEvent{})
+	knob.TappedSecondary(&fyne.PointEvent{})
+	knob.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(50, 10)}})
+	knob.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, 1)})
+	knob.TypedKey(&fyne.KeyEvent{Name: fyne.KeyUp})
+	knob.Nudge(3)
+
+	assert.Equal(t, 40.0, knob.Value(), "no handler mutates a disabled knob")
+	assert.False(t, knob.IsDragging())
+	assert.False(t, secondary, "secondary taps are suppressed too")
+}
+
+func TestRotatingKnob_TappedSecondary(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.Resize(fyne.NewSize(100, 100))
+
+	var at fyne.Position
+	knob.OnTappedSecondary = func(e *fyne.PointEvent) { at = e.Position }
+	knob.TappedSecondary(&fyne.PointEvent{Position: fyne.NewPos(30, 40)})
+	assert.Equal(t, fyne.NewPos(30, 40), at)
+}
+
+func BenchmarkRotatingKnobLayout(b *testing.B) {
+	knob := NewRotatingKnob(0, 100)
+	knob.TickCount = 11
+	render := knob.CreateRenderer()
+	render.Layout(fyne.NewSize(120, 120))
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		// the drag hot path: the value moves while the size stays put
+		knob.value = float64(i % 100)
+		render.Layout(fyne.NewSize(120, 120))
+	}
+}
+
+func TestRotatingKnob_SetValueSkipsFullLayout(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	render := test.TempWidgetRenderer(t, knob).(*rotatingKnobRenderer)
+	knob.Resize(fyne.NewSize(100, 100))
+
+	// nudge a static object aside; a full Layout pass would recentre it
+	render.track.Move(fyne.NewPos(1, 2))
+
+	before := render.indicator.Position2
+	knob.SetValue(40)
+	assert.NotEqual(t, before, render.indicator.Position2, "the indicator tracks the value")
+	assert.Equal(t, fyne.NewPos(1, 2), render.track.Position(), "a value change does not rerun the full layout")
+
+	// an actual geometry change still lays everything out again
+	knob.Resize(fyne.NewSize(120, 120))
+	assert.Equal(t, fyne.NewPos(60, 60), render.track.Position())
+}
+
+func TestRotatingKnob_SweepChangeRelaysTicks(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.TickCount = 3
+	knob.Resize(fyne.NewSize(100, 100))
+	render := test.TempWidgetRenderer(t, knob).(*rotatingKnobRenderer)
+	render.Refresh()
+	before := render.ticks[0].Position2
+
+	// changing the sweep at a constant size moves the decoration, not just the arcs
+	knob.StartAngle, knob.EndAngle = -90, 90
+	render.Refresh()
+	assert.Equal(t, float32(-90), render.track.StartAngle)
+	assert.NotEqual(t, before, render.ticks[0].Position2, "ticks follow the new sweep")
+
+	// the first tick now points due left, at the new start angle
+	_, radius := knob.Geometry()
+	tip := render.ticks[0].Position2
+	assert.InDelta(t, 50-float64(radius), float64(tip.X), 0.01)
+	assert.InDelta(t, 50, float64(tip.Y), 0.01)
+}
+
+func TestRotatingKnob_ScrolledDisabled(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	knob.ScrollAcceleration = true
+	knob.SetValue(40)
+	knob.Disable()
+
+	fired := 0
+	knob.OnChanged = func(float64) { fired++ }
+	knob.OnChangeEnded = func(float64) { fired++ }
+
+	// vertical, horizontal and rapid (acceleration-building) notches are all inert
+	knob.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, 1)})
+	knob.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, -1)})
+	knob.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(1, 0)})
+	knob.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, 1)})
+
+	// a held modifier changes nothing either
+	knob.KeyDown(&fyne.KeyEvent{Name: desktop.KeyShiftLeft})
+	knob.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, 1)})
+	knob.KeyUp(&fyne.KeyEvent{Name: desktop.KeyShiftLeft})
+
+	assert.Equal(t, 40.0, knob.Value())
+	assert.Equal(t, 0, fired, "no callback fires for a disabled knob")
+	assert.Equal(t, 0.0, knob.scrollFactor, "disabled scrolling builds no acceleration state")
+}
+
+func TestRotatingKnob_BindDisabled(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	locked := binding.NewBool()
+	knob.BindDisabled(locked)
+
+	assert.NoError(t, locked.Set(true))
+	waitForBinding()
+	assert.True(t, knob.Disabled())
+
+	assert.NoError(t, locked.Set(false))
+	waitForBinding()
+	assert.False(t, knob.Disabled())
+
+	// after unbinding the knob keeps its state and stops following the data
+	assert.NoError(t, locked.Set(true))
+	waitForBinding()
+	knob.UnbindDisabled()
+	assert.NoError(t, locked.Set(false))
+	waitForBinding()
+	assert.True(t, knob.Disabled())
+}
@@ -0,0 +1,279 @@
+package widget
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image/color"
+
+	"fyne.io/fyne/v2"
+)
+
+// knobConfig is the wire form of a knob's configuration, so a layout designer can
+// save and reload dashboards. Callbacks and the runtime value are deliberately
+// absent - only declarative setup travels. Colors are "#rrggbbaa" strings and nil
+// colors are omitted, falling back to the theme on load.
+type knobConfig struct {
+	Min  float64 `json:"min"`
+	Max  float64 `json:"max"`
+	Step float64 `json:"step,omitempty"`
+
+	StartAngle float32 `json:"startAngle"`
+	EndAngle   float32 `json:"endAngle"`
+
+	TickCount     int       `json:"tickCount,omitempty"`
+	TickPositions []float64 `json:"tickPositions,omitempty"`
+	TickLabels    []string  `json:"tickLabels,omitempty"`
+	TickTapRadius float32   `json:"tickTapRadius,omitempty"`
+	TickColor     string    `json:"tickColor,omitempty"`
+
+	Steps      []float64 `json:"steps,omitempty"`
+	StepLabels []string  `json:"stepLabels,omitempty"`
+	Notch      *float64  `json:"notch,omitempty"`
+	Rounding   int       `json:"rounding,omitempty"`
+
+	WedgeColor    string   `json:"wedgeColor,omitempty"`
+	WedgeGradient []string `json:"wedgeGradient,omitempty"`
+	GapColor      string   `json:"gapColor,omitempty"`
+	DisabledColor string   `json:"disabledColor,omitempty"`
+
+	DefaultValue         float64      `json:"defaultValue,omitempty"`
+	DragThreshold        float32      `json:"dragThreshold,omitempty"`
+	MinDiameter          float32      `json:"minDiameter,omitempty"`
+	CompactThreshold     float32      `json:"compactThreshold,omitempty"`
+	TapBandWidth         float32      `json:"tapBandWidth,omitempty"`
+	SpringTarget         float64      `json:"springTarget,omitempty"`
+	ScrollStepMultiplier float64      `json:"scrollStepMultiplier,omitempty"`
+	MinChangeDelta       float64      `json:"minChangeDelta,omitempty"`
+	ResetShortcut        fyne.KeyName `json:"resetShortcut,omitempty"`
+	StatusName           string       `json:"statusName,omitempty"`
+	MinReachedText       string       `json:"minReachedText,omitempty"`
+	MaxReachedText       string       `json:"maxReachedText,omitempty"`
+	HybridThreshold      float32      `json:"hybridThreshold,omitempty"`
+	TouchOffsetY         float32      `json:"touchOffsetY,omitempty"`
+	TrackThicknessRatio  float32      `json:"trackThicknessRatio,omitempty"`
+
+	ShowGap            bool `json:"showGap,omitempty"`
+	AutoRTL            bool `json:"autoRTL,omitempty"`
+	TapBandOnly        bool `json:"tapBandOnly,omitempty"`
+	HideIndicatorAtMin bool `json:"hideIndicatorAtMin,omitempty"`
+	HideIndicatorAtMax bool `json:"hideIndicatorAtMax,omitempty"`
+	Inverted           bool `json:"inverted,omitempty"`
+	Endless            bool `json:"endless,omitempty"`
+	IntegerOnly        bool `json:"integerOnly,omitempty"`
+	Wrapping           bool `json:"wrapping,omitempty"`
+	DisableAnimations  bool `json:"disableAnimations,omitempty"`
+	HoverAnimation     bool `json:"hoverAnimation,omitempty"`
+	SpringReturn       bool `json:"springReturn,omitempty"`
+	AllowEllipse       bool `json:"allowEllipse,omitempty"`
+	InvertScroll       bool `json:"invertScroll,omitempty"`
+	ScrollAcceleration bool `json:"scrollAcceleration,omitempty"`
+	AbsoluteDrag       bool `json:"absoluteDrag,omitempty"`
+	HybridDrag         bool `json:"hybridDrag,omitempty"`
+	HighContrast       bool `json:"highContrast,omitempty"`
+}
+
+// MarshalJSON encodes the knob's declarative configuration - range, angles, ticks,
+// colors and behaviour flags - so a layout can be saved and rebuilt later.
+// Callbacks and the current value are excluded.
+//
+// Since: 2.7
+func (k *RotatingKnob) MarshalJSON() ([]byte, error) {
+	var gradient []string
+	if k.WedgeGradient[0] != nil && k.WedgeGradient[1] != nil {
+		gradient = []string{hexForColor(k.WedgeGradient[0]), hexForColor(k.WedgeGradient[1])}
+	}
+
+	return json.Marshal(knobConfig{
+		Min:  k.Min,
+		Max:  k.Max,
+		Step: k.Step,
+
+		StartAngle: k.StartAngle,
+		EndAngle:   k.EndAngle,
+
+		TickCount:     k.TickCount,
+		TickPositions: k.TickPositions,
+		TickLabels:    k.TickLabels,
+		TickTapRadius: k.TickTapRadius,
+		TickColor:     hexForColor(k.TickColor),
+
+		Steps:      k.Steps,
+		StepLabels: k.StepLabels,
+		Notch:      k.Notch,
+		Rounding:   int(k.Rounding),
+
+		WedgeColor:    hexForColor(k.WedgeColor),
+		WedgeGradient: gradient,
+		GapColor:      hexForColor(k.GapColor),
+		DisabledColor: hexForColor(k.DisabledColor),
+
+		DefaultValue:         k.DefaultValue,
+		DragThreshold:        k.DragThreshold,
+		MinDiameter:          k.MinDiameter,
+		CompactThreshold:     k.CompactThreshold,
+		TapBandWidth:         k.TapBandWidth,
+		SpringTarget:         k.SpringTarget,
+		ScrollStepMultiplier: k.ScrollStepMultiplier,
+		MinChangeDelta:       k.MinChangeDelta,
+		ResetShortcut:        k.ResetShortcut,
+		StatusName:           k.StatusName,
+		MinReachedText:       k.MinReachedText,
+		MaxReachedText:       k.MaxReachedText,
+		HybridThreshold:      k.HybridThreshold,
+		TouchOffsetY:         k.TouchOffsetY,
+		TrackThicknessRatio:  k.TrackThicknessRatio,
+
+		ShowGap:            k.ShowGap,
+		AutoRTL:            k.AutoRTL,
+		TapBandOnly:        k.TapBandOnly,
+		HideIndicatorAtMin: k.HideIndicatorAtMin,
+		HideIndicatorAtMax: k.HideIndicatorAtMax,
+		Inverted:           k.Inverted,
+		Endless:            k.Endless,
+		IntegerOnly:        k.IntegerOnly,
+		Wrapping:           k.Wrapping,
+		DisableAnimations:  k.DisableAnimations,
+		HoverAnimation:     k.HoverAnimation,
+		SpringReturn:       k.SpringReturn,
+		AllowEllipse:       k.AllowEllipse,
+		InvertScroll:       k.InvertScroll,
+		ScrollAcceleration: k.ScrollAcceleration,
+		AbsoluteDrag:       k.AbsoluteDrag,
+		HybridDrag:         k.HybridDrag,
+		HighContrast:       k.HighContrast,
+	})
+}
+
+// UnmarshalJSON applies a configuration saved by MarshalJSON to this knob. The
+// current value, callbacks and any data binding are left untouched; call Refresh
+// afterwards if the knob is already shown.
+//
+// Since: 2.7
+func (k *RotatingKnob) UnmarshalJSON(data []byte) error {
+	var conf knobConfig
+	if err := json.Unmarshal(data, &conf); err != nil {
+		return err
+	}
+
+	tickColor, err := colorForHex(conf.TickColor)
+	if err != nil {
+		return err
+	}
+	wedgeColor, err := colorForHex(conf.WedgeColor)
+	if err != nil {
+		return err
+	}
+	gapColor, err := colorForHex(conf.GapColor)
+	if err != nil {
+		return err
+	}
+	disabledColor, err := colorForHex(conf.DisabledColor)
+	if err != nil {
+		return err
+	}
+	var gradient [2]color.Color
+	if len(conf.WedgeGradient) > 0 {
+		if len(conf.WedgeGradient) != 2 {
+			return errors.New("wedgeGradient must hold exactly two colors")
+		}
+		for i, s := range conf.WedgeGradient {
+			if gradient[i], err = colorForHex(s); err != nil {
+				return err
+			}
+		}
+	}
+
+	k.Min = conf.Min
+	k.Max = conf.Max
+	k.Step = conf.Step
+
+	k.StartAngle = conf.StartAngle
+	k.EndAngle = conf.EndAngle
+
+	k.TickCount = conf.TickCount
+	k.TickPositions = conf.TickPositions
+	k.TickLabels = conf.TickLabels
+	k.TickTapRadius = conf.TickTapRadius
+	k.TickColor = tickColor
+
+	k.Steps = conf.Steps
+	k.StepLabels = conf.StepLabels
+	k.Notch = conf.Notch
+	k.Rounding = KnobRounding(conf.Rounding)
+
+	k.WedgeColor = wedgeColor
+	k.WedgeGradient = gradient
+	k.GapColor = gapColor
+	k.DisabledColor = disabledColor
+
+	k.DefaultValue = conf.DefaultValue
+	k.DragThreshold = conf.DragThreshold
+	k.MinDiameter = conf.MinDiameter
+	k.CompactThreshold = conf.CompactThreshold
+	k.TapBandWidth = conf.TapBandWidth
+	k.SpringTarget = conf.SpringTarget
+	k.ScrollStepMultiplier = conf.ScrollStepMultiplier
+	k.MinChangeDelta = conf.MinChangeDelta
+	k.ResetShortcut = conf.ResetShortcut
+	k.StatusName = conf.StatusName
+	k.MinReachedText = conf.MinReachedText
+	k.MaxReachedText = conf.MaxReachedText
+	k.HybridThreshold = conf.HybridThreshold
+	k.TouchOffsetY = conf.TouchOffsetY
+	k.TrackThicknessRatio = conf.TrackThicknessRatio
+
+	k.ShowGap = conf.ShowGap
+	k.AutoRTL = conf.AutoRTL
+	k.TapBandOnly = conf.TapBandOnly
+	k.HideIndicatorAtMin = conf.HideIndicatorAtMin
+	k.HideIndicatorAtMax = conf.HideIndicatorAtMax
+	k.Inverted = conf.Inverted
+	k.Endless = conf.Endless
+	k.IntegerOnly = conf.IntegerOnly
+	k.Wrapping = conf.Wrapping
+	k.DisableAnimations = conf.DisableAnimations
+	k.HoverAnimation = conf.HoverAnimation
+	k.SpringReturn = conf.SpringReturn
+	k.AllowEllipse = conf.AllowEllipse
+	k.InvertScroll = conf.InvertScroll
+	k.ScrollAcceleration = conf.ScrollAcceleration
+	k.AbsoluteDrag = conf.AbsoluteDrag
+	k.HybridDrag = conf.HybridDrag
+	k.HighContrast = conf.HighContrast
+
+	return nil
+}
+
+// hexForColor encodes a color as "#rrggbbaa", or empty for nil so the theme
+// fallback survives a round trip.
+func hexForColor(c color.Color) string {
+	if c == nil {
+		return ""
+	}
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	return fmt.Sprintf("#%02x%02x%02x%02x", n.R, n.G, n.B, n.A)
+}
+
+// colorForHex decodes a "#rrggbbaa" or "#rrggbb" string, returning nil for empty.
+func colorForHex(s string) (color.Color, error) {
+	if s == "" {
+		return nil, nil
+	}
+	if s[0] == '#' {
+		s = s[1:]
+	}
+	digits, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(digits) != 3 && len(digits) != 4 {
+		return nil, errors.New("invalid color format: #" + s)
+	}
+	c := color.NRGBA{R: digits[0], G: digits[1], B: digits[2], A: 0xff}
+	if len(digits) == 4 {
+		c.A = digits[3]
+	}
+	return c, nil
+}
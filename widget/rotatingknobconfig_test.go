@@ -0,0 +1,68 @@
+package widget
+
+import (
+	"encoding/json"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2"
+)
+
+func TestRotatingKnob_JSONRoundTrip(t *testing.T) {
+	knob := NewRotatingKnob(-10, 10)
+	knob.Step = 0.5
+	knob.StartAngle = -120
+	knob.EndAngle = 120
+	knob.TickCount = 7
+	knob.TickColor = color.NRGBA{R: 0x10, G: 0x20, B: 0x30, A: 0xff}
+	knob.Steps = []float64{-10, 0, 10}
+	knob.StepLabels = []string{"Low", "Mid", "High"}
+	knob.Wrapping = true
+	knob.IntegerOnly = true
+	knob.ResetShortcut = fyne.KeyR
+	knob.Rounding = RoundHalfEven
+	knob.WedgeGradient = [2]color.Color{
+		color.NRGBA{R: 0xff, A: 0xff},
+		color.NRGBA{B: 0xff, A: 0xff}}
+	knob.MinChangeDelta = 0.25
+	knob.MinReachedText = "at the floor"
+	knob.MaxReachedText = "at the ceiling"
+	knob.SetValue(5)
+
+	data, err := json.Marshal(knob)
+	assert.NoError(t, err)
+
+	loaded := NewRotatingKnob(0, 1)
+	assert.NoError(t, json.Unmarshal(data, loaded))
+
+	assert.Equal(t, -10.0, loaded.Min)
+	assert.Equal(t, 10.0, loaded.Max)
+	assert.Equal(t, 0.5, loaded.Step)
+	assert.Equal(t, float32(-120), loaded.StartAngle)
+	assert.Equal(t, 7, loaded.TickCount)
+	assert.Equal(t, color.NRGBA{R: 0x10, G: 0x20, B: 0x30, A: 0xff}, loaded.TickColor)
+	assert.Equal(t, []float64{-10, 0, 10}, loaded.Steps)
+	assert.Equal(t, []string{"Low", "Mid", "High"}, loaded.StepLabels)
+	assert.True(t, loaded.Wrapping)
+	assert.True(t, loaded.IntegerOnly)
+	assert.Equal(t, fyne.KeyR, loaded.ResetShortcut)
+	assert.Equal(t, RoundHalfEven, loaded.Rounding)
+	assert.Equal(t, [2]color.Color{
+		color.NRGBA{R: 0xff, A: 0xff},
+		color.NRGBA{B: 0xff, A: 0xff}}, loaded.WedgeGradient)
+	assert.Equal(t, 0.25, loaded.MinChangeDelta)
+	assert.Equal(t, "at the floor", loaded.MinReachedText)
+	assert.Equal(t, "at the ceiling", loaded.MaxReachedText)
+	assert.True(t, loaded.AbsoluteDrag, "constructor defaults travel too")
+
+	assert.Equal(t, 0.0, loaded.Value(), "the runtime value does not travel")
+	assert.Nil(t, loaded.WedgeColor, "unset colors stay nil for the theme fallback")
+}
+
+func TestRotatingKnob_UnmarshalJSON_BadColor(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	err := json.Unmarshal([]byte(`{"min":0,"max":1,"tickColor":"#zzz"}`), knob)
+	assert.Error(t, err)
+}
@@ -0,0 +1,36 @@
+package widget
+
+import (
+	"sort"
+
+	"fyne.io/fyne/v2"
+)
+
+// NewShortcutHelp returns a scrollable two-column table listing each key beside
+// the action it performs, sorted by key. Custom interactive widgets can surface
+// their keyboard controls through it consistently instead of embedding long
+// help text by hand; pair it with RotatingKnob.ShortcutHelp for the knob's
+// bindings.
+//
+// Since: 2.7
+func NewShortcutHelp(bindings map[string]string) *Table {
+	keys := make([]string, 0, len(bindings))
+	for key := range bindings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return NewTable(
+		func() (rows, cols int) { return len(keys), 2 },
+		func() fyne.CanvasObject { return NewLabel("") },
+		func(id TableCellID, cell fyne.CanvasObject) {
+			label := cell.(*Label)
+			if id.Col == 0 {
+				label.TextStyle = fyne.TextStyle{Bold: true}
+				label.SetText(keys[id.Row])
+				return
+			}
+			label.TextStyle = fyne.TextStyle{}
+			label.SetText(bindings[keys[id.Row]])
+		})
+}
@@ -0,0 +1,40 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2"
+)
+
+func TestNewShortcutHelp(t *testing.T) {
+	help := NewShortcutHelp(map[string]string{
+		"B": "second action",
+		"A": "first action",
+	})
+
+	rows, cols := help.Length()
+	assert.Equal(t, 2, rows)
+	assert.Equal(t, 2, cols)
+
+	// keys sort alphabetically, bold in the first column
+	label := NewLabel("")
+	help.UpdateCell(TableCellID{Row: 0, Col: 0}, label)
+	assert.Equal(t, "A", label.Text)
+	assert.True(t, label.TextStyle.Bold)
+
+	help.UpdateCell(TableCellID{Row: 1, Col: 1}, label)
+	assert.Equal(t, "second action", label.Text)
+	assert.False(t, label.TextStyle.Bold)
+}
+
+func TestRotatingKnob_ShortcutHelp(t *testing.T) {
+	knob := NewRotatingKnob(0, 100)
+	help := knob.ShortcutHelp()
+	assert.Contains(t, help, "Up / Right")
+	assert.NotContains(t, help, "R")
+
+	knob.ResetShortcut = fyne.KeyR
+	assert.Equal(t, "Reset to the default value", knob.ShortcutHelp()["R"])
+}